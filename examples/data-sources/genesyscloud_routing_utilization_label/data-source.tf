@@ -0,0 +1,3 @@
+data "genesyscloud_routing_utilization_label" "vip_chat" {
+  name = "VIP Chat"
+}
\ No newline at end of file
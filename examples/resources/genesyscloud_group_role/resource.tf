@@ -0,0 +1,5 @@
+resource "genesyscloud_group_role" "group1-custom-role" {
+  group_id     = genesyscloud_group.group1.id
+  role_id      = genesyscloud_auth_role.custom-role.id
+  division_ids = [genesyscloud_auth_division.marketing.id]
+}
\ No newline at end of file
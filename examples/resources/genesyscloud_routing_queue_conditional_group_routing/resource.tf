@@ -0,0 +1,22 @@
+resource "genesyscloud_routing_queue_conditional_group_routing" "example_queue_rules" {
+  queue_id = genesyscloud_routing_queue.example_queue.id
+  rules {
+    operator        = "GreaterThan"
+    condition_value = 30
+    wait_seconds    = 10
+    groups {
+      member_group_id   = genesyscloud_group.example_group.id
+      member_group_type = "GROUP"
+    }
+  }
+  rules {
+    evaluated_queue_id = genesyscloud_routing_queue.example_queue2.id
+    operator           = "LessThan"
+    condition_value    = 15
+    wait_seconds       = 20
+    groups {
+      member_group_id   = genesyscloud_group.example_group2.id
+      member_group_type = "GROUP"
+    }
+  }
+}
@@ -28,4 +28,13 @@ resource "genesyscloud_routing_utilization" "org-utililzation" {
     include_non_acd           = false
     interruptible_media_types = ["call", "chat"]
   }
+  label_utilizations {
+    label_id         = genesyscloud_routing_utilization_label.vip_chat.id
+    maximum_capacity = 1
+  }
+  label_utilizations {
+    label_id               = genesyscloud_routing_utilization_label.standard_chat.id
+    maximum_capacity       = 3
+    interrupting_label_ids = [genesyscloud_routing_utilization_label.vip_chat.id]
+  }
 }
\ No newline at end of file
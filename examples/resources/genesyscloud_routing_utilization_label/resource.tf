@@ -0,0 +1,3 @@
+resource "genesyscloud_routing_utilization_label" "vip_chat" {
+  name = "VIP Chat"
+}
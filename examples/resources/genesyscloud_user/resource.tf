@@ -70,4 +70,18 @@ resource "genesyscloud_user" "test_user" {
       interruptible_media_types = ["call", "chat"]
     }
   }
+  voicemail_userpolicies {
+    alert_timeout_seconds    = 30
+    send_email_notifications = true
+  }
+  call_forwarding {
+    enabled   = true
+    voicemail = "PURECLOUD"
+    calls {
+      targets {
+        type  = "PHONE"
+        value = "+13175550100"
+      }
+    }
+  }
 }
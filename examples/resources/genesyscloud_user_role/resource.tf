@@ -0,0 +1,5 @@
+resource "genesyscloud_user_role" "user1-custom-role" {
+  user_id      = genesyscloud_user.user1.id
+  role_id      = genesyscloud_auth_role.custom-role.id
+  division_ids = [genesyscloud_auth_division.marketing.id]
+}
\ No newline at end of file
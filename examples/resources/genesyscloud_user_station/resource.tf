@@ -0,0 +1,4 @@
+resource "genesyscloud_user_station" "user1-station" {
+  user_id            = genesyscloud_user.user1.id
+  default_station_id = data.genesyscloud_station.user1-webrtc.id
+}
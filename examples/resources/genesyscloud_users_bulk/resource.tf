@@ -0,0 +1,7 @@
+# users.csv:
+# email,name,division,manager_email,skills,languages
+# jsmith@example.com,John Smith,,,,English:5
+# agent1@example.com,Agent One,Sales,jsmith@example.com,Sales:3;Support:4.5,English:4;Spanish:2
+resource "genesyscloud_users_bulk" "agents" {
+  source_file = "${path.module}/users.csv"
+}
@@ -0,0 +1,398 @@
+package genesyscloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+// API proxies wrap the Public API calls made by resources so they can be replaced with in-memory fakes in unit tests.
+// Proxies are resolved from the providerMeta. The SDK API types implement the proxy interfaces unless
+// a call has to be constructed manually.
+
+// routingProxy contains the Routing API methods used by resources
+type routingProxy interface {
+	GetRoutingQueue(queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
+	PostRoutingQueues(body platformclientv2.Createqueuerequest) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
+	GetRoutingQueueWithExtensions(queueId string) (*platformclientv2.Queue, *queueExtensions, *platformclientv2.APIResponse, error)
+	PutRoutingQueueWithExtensions(queueId string, body platformclientv2.Queuerequest, extensions queueExtensions) (*platformclientv2.APIResponse, error)
+	GetRoutingQueueConditionalGroupRouting(queueId string) (*conditionalGroupRouting, *platformclientv2.APIResponse, error)
+	PutRoutingQueueConditionalGroupRouting(queueId string, body conditionalGroupRouting) (*conditionalGroupRouting, *platformclientv2.APIResponse, error)
+	DeleteRoutingQueue(queueId string, forceDelete bool) (*platformclientv2.APIResponse, error)
+	GetRoutingQueueMembers(queueId string, pageNumber int, pageSize int, name string) (*platformclientv2.Queuememberentitylisting, *platformclientv2.APIResponse, error)
+	PostRoutingQueueMembers(queueId string, body []platformclientv2.Writableentity, delete bool) (*platformclientv2.APIResponse, error)
+	PatchRoutingQueueMember(queueId string, memberId string, body platformclientv2.Queuemember) (*platformclientv2.APIResponse, error)
+	GetRoutingQueueWrapupcodes(queueId string, pageSize int, pageNumber int) (*platformclientv2.Wrapupcodeentitylisting, *platformclientv2.APIResponse, error)
+	PostRoutingQueueWrapupcodes(queueId string, body []platformclientv2.Wrapupcodereference) ([]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error)
+	DeleteRoutingQueueWrapupcode(queueId string, codeId string) (*platformclientv2.APIResponse, error)
+
+	GetRoutingSkill(skillId string) (*platformclientv2.Routingskill, *platformclientv2.APIResponse, error)
+	PostRoutingSkills(body platformclientv2.Routingskill) (*platformclientv2.Routingskill, *platformclientv2.APIResponse, error)
+	DeleteRoutingSkill(skillId string) (*platformclientv2.APIResponse, error)
+
+	GetRoutingWrapupcode(codeId string) (*platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error)
+	PostRoutingWrapupcodes(body platformclientv2.Wrapupcode) (*platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error)
+	PutRoutingWrapupcode(codeId string, body platformclientv2.Wrapupcode) (*platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error)
+	DeleteRoutingWrapupcode(codeId string) (*platformclientv2.APIResponse, error)
+
+	GetRoutingUtilizationLabel(labelId string) (*utilizationLabel, *platformclientv2.APIResponse, error)
+	GetRoutingUtilizationLabels(pageSize int, pageNumber int, name string) (*utilizationLabelEntityListing, *platformclientv2.APIResponse, error)
+	PostRoutingUtilizationLabels(body utilizationLabel) (*utilizationLabel, *platformclientv2.APIResponse, error)
+	PutRoutingUtilizationLabel(labelId string, body utilizationLabel) (*utilizationLabel, *platformclientv2.APIResponse, error)
+	DeleteRoutingUtilizationLabel(labelId string, forceDelete bool) (*platformclientv2.APIResponse, error)
+	GetRoutingUtilizationWithLabels() (*labelledUtilization, *platformclientv2.APIResponse, error)
+	PutRoutingUtilizationWithLabels(body labelledUtilization) (*platformclientv2.APIResponse, error)
+	GetRoutingUserUtilizationWithLabels(userId string) (*labelledUtilization, *platformclientv2.APIResponse, error)
+	PutRoutingUserUtilizationWithLabels(userId string, body labelledUtilization) (*platformclientv2.APIResponse, error)
+}
+
+// usersProxy contains the Users API methods used by resources and data sources
+type usersProxy interface {
+	GetUser(userId string, expand []string, integrationPresenceSource string, state string) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+	GetUsers(pageSize int, pageNumber int, id []string, jabberId []string, sortOrder string, expand []string, integrationPresenceSource string, state string) (*platformclientv2.Userentitylisting, *platformclientv2.APIResponse, error)
+	PostUsers(body platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+	PostUsersSearch(body platformclientv2.Usersearchrequest) (*platformclientv2.Userssearchresponse, *platformclientv2.APIResponse, error)
+	PatchUser(userId string, body platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+	DeleteUser(userId string) (*platformclientv2.Empty, *platformclientv2.APIResponse, error)
+
+	PutUserRoutingskillsBulk(userId string, body []platformclientv2.Userroutingskillpost) (*platformclientv2.Userskillentitylisting, *platformclientv2.APIResponse, error)
+	GetUserRoutinglanguages(userId string, pageSize int, pageNumber int, sortOrder string) (*platformclientv2.Userlanguageentitylisting, *platformclientv2.APIResponse, error)
+	PatchUserRoutinglanguagesBulk(userId string, body []platformclientv2.Userroutinglanguagepost) (*platformclientv2.Userlanguageentitylisting, *platformclientv2.APIResponse, error)
+	DeleteUserRoutinglanguage(userId string, languageId string) (*platformclientv2.APIResponse, error)
+	PutUserProfileskills(userId string, body []string) ([]string, *platformclientv2.APIResponse, error)
+	DeleteRoutingUserUtilization(userId string) (*platformclientv2.APIResponse, error)
+
+	GetUserCallforwarding(userId string) (*platformclientv2.Callforwarding, *platformclientv2.APIResponse, error)
+	PutUserCallforwarding(userId string, body platformclientv2.Callforwarding) (*platformclientv2.Callforwarding, *platformclientv2.APIResponse, error)
+
+	GetUserStation(userId string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error)
+	PutUserStationDefaultstationStationId(userId string, stationId string) (*platformclientv2.APIResponse, error)
+	DeleteUserStationDefaultstation(userId string) (*platformclientv2.APIResponse, error)
+	PutUserStationAssociatedstationStationId(userId string, stationId string) (*platformclientv2.APIResponse, error)
+	DeleteUserStationAssociatedstation(userId string) (*platformclientv2.APIResponse, error)
+}
+
+// architectProxy contains the Architect API methods used by resources
+type architectProxy interface {
+	GetArchitectSchedule(scheduleId string) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error)
+	PostArchitectSchedules(body platformclientv2.Schedule) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error)
+	PutArchitectSchedule(scheduleId string, body platformclientv2.Schedule) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error)
+	DeleteArchitectSchedule(scheduleId string) (*platformclientv2.APIResponse, error)
+}
+
+// telephonyProxy contains the Telephony Providers Edge API methods used by resources
+type telephonyProxy interface {
+	GetTelephonyProvidersEdgesDidpool(didPoolId string) (*platformclientv2.Didpool, *platformclientv2.APIResponse, error)
+	PostTelephonyProvidersEdgesDidpools(body platformclientv2.Didpool) (*platformclientv2.Didpool, *platformclientv2.APIResponse, error)
+	PutTelephonyProvidersEdgesDidpool(didPoolId string, body platformclientv2.Didpool) (*platformclientv2.Didpool, *platformclientv2.APIResponse, error)
+	DeleteTelephonyProvidersEdgesDidpool(didPoolId string) (*platformclientv2.APIResponse, error)
+}
+
+// authorizationProxy contains the Authorization API methods used by resources
+type authorizationProxy interface {
+	GetAuthorizationDivision(divisionId string, objectCount bool) (*platformclientv2.Authzdivision, *platformclientv2.APIResponse, error)
+	PostAuthorizationDivisions(body platformclientv2.Authzdivision) (*platformclientv2.Authzdivision, *platformclientv2.APIResponse, error)
+	PutAuthorizationDivision(divisionId string, body platformclientv2.Authzdivision) (*platformclientv2.Authzdivision, *platformclientv2.APIResponse, error)
+	DeleteAuthorizationDivision(divisionId string, force bool) (*platformclientv2.APIResponse, error)
+}
+
+// apiProxies overrides the SDK proxies returned by providerMeta. Nil proxies use the SDK.
+type apiProxies struct {
+	routing       routingProxy
+	users         usersProxy
+	architect     architectProxy
+	telephony     telephonyProxy
+	authorization authorizationProxy
+}
+
+func (m *providerMeta) routingProxy() routingProxy {
+	if m.proxies != nil && m.proxies.routing != nil {
+		return m.proxies.routing
+	}
+	return &sdkRoutingProxy{platformclientv2.NewRoutingApiWithConfig(m.ClientConfig)}
+}
+
+func (m *providerMeta) usersProxy() usersProxy {
+	if m.proxies != nil && m.proxies.users != nil {
+		return m.proxies.users
+	}
+	return platformclientv2.NewUsersApiWithConfig(m.ClientConfig)
+}
+
+func (m *providerMeta) architectProxy() architectProxy {
+	if m.proxies != nil && m.proxies.architect != nil {
+		return m.proxies.architect
+	}
+	return platformclientv2.NewArchitectApiWithConfig(m.ClientConfig)
+}
+
+func (m *providerMeta) telephonyProxy() telephonyProxy {
+	if m.proxies != nil && m.proxies.telephony != nil {
+		return m.proxies.telephony
+	}
+	return platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(m.ClientConfig)
+}
+
+func (m *providerMeta) authorizationProxy() authorizationProxy {
+	if m.proxies != nil && m.proxies.authorization != nil {
+		return m.proxies.authorization
+	}
+	return platformclientv2.NewAuthorizationApiWithConfig(m.ClientConfig)
+}
+
+// sdkRoutingProxy adds routing calls that must be constructed manually to the SDK Routing API
+type sdkRoutingProxy struct {
+	*platformclientv2.RoutingApi
+}
+
+func (p *sdkRoutingProxy) GetRoutingQueueMembers(queueID string, pageNumber int, pageSize int, name string) (*platformclientv2.Queuememberentitylisting, *platformclientv2.APIResponse, error) {
+	// SDK does not support nil values for boolean query params yet, so we must manually construct this HTTP request for now
+	queryParams := make(map[string]string)
+	queryParams["pageSize"] = fmt.Sprintf("%v", pageSize)
+	queryParams["pageNumber"] = fmt.Sprintf("%v", pageNumber)
+	if name != "" {
+		queryParams["name"] = name
+	}
+
+	var successPayload *platformclientv2.Queuememberentitylisting
+	response, err := p.callAPI(http.MethodGet, "/api/v2/routing/queues/"+url.PathEscape(queueID)+"/members", queryParams, nil, &successPayload)
+	return successPayload, response, err
+}
+
+// queueExtensions contains queue settings that are not yet included in the SDK queue models
+type queueExtensions struct {
+	MemberGroups      *[]platformclientv2.Membergroup         `json:"memberGroups,omitempty"`
+	AgentOwnedRouting *platformclientv2.Agentownedrouting     `json:"agentOwnedRouting,omitempty"`
+	DirectRouting     *queueDirectRouting                     `json:"directRouting,omitempty"`
+	MediaSettings     *map[string]queueMediaSettingExtensions `json:"mediaSettings,omitempty"`
+}
+
+type queueDirectRouting struct {
+	CallMediaSettings    *queueDirectRoutingMediaSettings `json:"callMediaSettings,omitempty"`
+	EmailMediaSettings   *queueDirectRoutingMediaSettings `json:"emailMediaSettings,omitempty"`
+	MessageMediaSettings *queueDirectRoutingMediaSettings `json:"messageMediaSettings,omitempty"`
+	BackupQueueId        *string                          `json:"backupQueueId,omitempty"`
+	WaitForAgent         *bool                            `json:"waitForAgent,omitempty"`
+	AgentWaitSeconds     *int                             `json:"agentWaitSeconds,omitempty"`
+}
+
+type queueDirectRoutingMediaSettings struct {
+	UseAgentAddressOutbound *bool `json:"useAgentAddressOutbound,omitempty"`
+}
+
+// queueMediaSettingExtensions contains the media settings missing from platformclientv2.Mediasetting.
+// The callback settings only apply to callback media.
+type queueMediaSettingExtensions struct {
+	EnableAutoAnswer             *bool    `json:"enableAutoAnswer,omitempty"`
+	AutoAnswerAlertToneSeconds   *float64 `json:"autoAnswerAlertToneSeconds,omitempty"`
+	ManualAnswerAlertToneSeconds *float64 `json:"manualAnswerAlertToneSeconds,omitempty"`
+	Mode                         *string  `json:"mode,omitempty"`
+	EnableAutoDialAndEnd         *bool    `json:"enableAutoDialAndEnd,omitempty"`
+	AutoDialDelaySeconds         *int     `json:"autoDialDelaySeconds,omitempty"`
+	AutoEndDelaySeconds          *int     `json:"autoEndDelaySeconds,omitempty"`
+}
+
+// GetRoutingQueueWithExtensions decodes the queue and its extensions from a single read of the queue
+func (p *sdkRoutingProxy) GetRoutingQueueWithExtensions(queueID string) (*platformclientv2.Queue, *queueExtensions, *platformclientv2.APIResponse, error) {
+	var queue *platformclientv2.Queue
+	response, err := p.callAPI(http.MethodGet, "/api/v2/routing/queues/"+url.PathEscape(queueID), nil, nil, &queue)
+	if err != nil {
+		return nil, nil, response, err
+	}
+
+	var extensions *queueExtensions
+	if err := json.Unmarshal(response.RawBody, &extensions); err != nil {
+		return nil, nil, response, err
+	}
+	return queue, extensions, response, nil
+}
+
+func (p *sdkRoutingProxy) PutRoutingQueueWithExtensions(queueID string, body platformclientv2.Queuerequest, extensions queueExtensions) (*platformclientv2.APIResponse, error) {
+	// The queue is replaced on update, so the extensions are merged into the rest of the queue
+	putBody, err := toJSONMap(&body)
+	if err != nil {
+		return nil, err
+	}
+	extensionsMap, err := toJSONMap(extensions)
+	if err != nil {
+		return nil, err
+	}
+	mergeJSONMaps(putBody, extensionsMap)
+
+	return p.callAPI(http.MethodPut, "/api/v2/routing/queues/"+url.PathEscape(queueID), nil, putBody, nil)
+}
+
+func toJSONMap(value interface{}) (map[string]interface{}, error) {
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]interface{})
+	if err := json.Unmarshal(valueJSON, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// mergeJSONMaps sets the values from src in dst. Nested objects in both maps are merged.
+func mergeJSONMaps(dst map[string]interface{}, src map[string]interface{}) {
+	for key, srcValue := range src {
+		srcMap, srcIsMap := srcValue.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeJSONMaps(dstMap, srcMap)
+		} else {
+			dst[key] = srcValue
+		}
+	}
+}
+
+// conditionalGroupRouting contains the conditional group routing rules for a queue, which are not yet supported by the SDK
+type conditionalGroupRouting struct {
+	Rules *[]conditionalGroupRoutingRule `json:"rules,omitempty"`
+}
+
+type conditionalGroupRoutingRule struct {
+	EvaluatedQueue *platformclientv2.Domainentityref `json:"evaluatedQueue,omitempty"`
+	Metric         *string                           `json:"metric,omitempty"`
+	Operator       *string                           `json:"operator,omitempty"`
+	ConditionValue *float64                          `json:"conditionValue,omitempty"`
+	Groups         *[]conditionalGroupRoutingGroup   `json:"groups,omitempty"`
+	WaitSeconds    *int                              `json:"waitSeconds,omitempty"`
+}
+
+type conditionalGroupRoutingGroup struct {
+	Member     *platformclientv2.Domainentityref `json:"member,omitempty"`
+	MemberType *string                           `json:"memberType,omitempty"`
+}
+
+func (p *sdkRoutingProxy) GetRoutingQueueConditionalGroupRouting(queueID string) (*conditionalGroupRouting, *platformclientv2.APIResponse, error) {
+	var successPayload *conditionalGroupRouting
+	response, err := p.callAPI(http.MethodGet, "/api/v2/routing/queues/"+url.PathEscape(queueID)+"/conditionalgroup/routing", nil, nil, &successPayload)
+	return successPayload, response, err
+}
+
+func (p *sdkRoutingProxy) PutRoutingQueueConditionalGroupRouting(queueID string, body conditionalGroupRouting) (*conditionalGroupRouting, *platformclientv2.APIResponse, error) {
+	var successPayload *conditionalGroupRouting
+	response, err := p.callAPI(http.MethodPut, "/api/v2/routing/queues/"+url.PathEscape(queueID)+"/conditionalgroup/routing", nil, body, &successPayload)
+	return successPayload, response, err
+}
+
+// utilizationLabel is a label that can be assigned to conversations to set their utilization, which is not yet supported by the SDK
+type utilizationLabel struct {
+	Id      *string `json:"id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Version *int    `json:"version,omitempty"`
+}
+
+type utilizationLabelEntityListing struct {
+	Entities  *[]utilizationLabel `json:"entities,omitempty"`
+	PageCount *int                `json:"pageCount,omitempty"`
+}
+
+// labelledUtilization contains media type and label utilization settings. Label utilizations are not yet supported by the SDK.
+type labelledUtilization struct {
+	Level             *string                                       `json:"level,omitempty"`
+	Utilization       *map[string]platformclientv2.Mediautilization `json:"utilization,omitempty"`
+	LabelUtilizations *map[string]labelUtilization                  `json:"labelUtilizations,omitempty"`
+}
+
+type labelUtilization struct {
+	MaximumCapacity      *int      `json:"maximumCapacity,omitempty"`
+	InterruptingLabelIds *[]string `json:"interruptingLabelIds,omitempty"`
+}
+
+func (p *sdkRoutingProxy) GetRoutingUtilizationLabel(labelID string) (*utilizationLabel, *platformclientv2.APIResponse, error) {
+	var successPayload *utilizationLabel
+	response, err := p.callAPI(http.MethodGet, "/api/v2/routing/utilization/labels/"+url.PathEscape(labelID), nil, nil, &successPayload)
+	return successPayload, response, err
+}
+
+func (p *sdkRoutingProxy) GetRoutingUtilizationLabels(pageSize int, pageNumber int, name string) (*utilizationLabelEntityListing, *platformclientv2.APIResponse, error) {
+	queryParams := make(map[string]string)
+	queryParams["pageSize"] = fmt.Sprintf("%v", pageSize)
+	queryParams["pageNumber"] = fmt.Sprintf("%v", pageNumber)
+	if name != "" {
+		queryParams["name"] = name
+	}
+
+	var successPayload *utilizationLabelEntityListing
+	response, err := p.callAPI(http.MethodGet, "/api/v2/routing/utilization/labels", queryParams, nil, &successPayload)
+	return successPayload, response, err
+}
+
+func (p *sdkRoutingProxy) PostRoutingUtilizationLabels(body utilizationLabel) (*utilizationLabel, *platformclientv2.APIResponse, error) {
+	var successPayload *utilizationLabel
+	response, err := p.callAPI(http.MethodPost, "/api/v2/routing/utilization/labels", nil, body, &successPayload)
+	return successPayload, response, err
+}
+
+func (p *sdkRoutingProxy) PutRoutingUtilizationLabel(labelID string, body utilizationLabel) (*utilizationLabel, *platformclientv2.APIResponse, error) {
+	var successPayload *utilizationLabel
+	response, err := p.callAPI(http.MethodPut, "/api/v2/routing/utilization/labels/"+url.PathEscape(labelID), nil, body, &successPayload)
+	return successPayload, response, err
+}
+
+func (p *sdkRoutingProxy) DeleteRoutingUtilizationLabel(labelID string, forceDelete bool) (*platformclientv2.APIResponse, error) {
+	queryParams := map[string]string{"forceDelete": fmt.Sprintf("%v", forceDelete)}
+	return p.callAPI(http.MethodDelete, "/api/v2/routing/utilization/labels/"+url.PathEscape(labelID), queryParams, nil, nil)
+}
+
+func (p *sdkRoutingProxy) GetRoutingUtilizationWithLabels() (*labelledUtilization, *platformclientv2.APIResponse, error) {
+	var successPayload *labelledUtilization
+	response, err := p.callAPI(http.MethodGet, "/api/v2/routing/utilization", nil, nil, &successPayload)
+	return successPayload, response, err
+}
+
+func (p *sdkRoutingProxy) PutRoutingUtilizationWithLabels(body labelledUtilization) (*platformclientv2.APIResponse, error) {
+	return p.callAPI(http.MethodPut, "/api/v2/routing/utilization", nil, body, nil)
+}
+
+func (p *sdkRoutingProxy) GetRoutingUserUtilizationWithLabels(userID string) (*labelledUtilization, *platformclientv2.APIResponse, error) {
+	var successPayload *labelledUtilization
+	response, err := p.callAPI(http.MethodGet, "/api/v2/routing/users/"+url.PathEscape(userID)+"/utilization", nil, nil, &successPayload)
+	return successPayload, response, err
+}
+
+func (p *sdkRoutingProxy) PutRoutingUserUtilizationWithLabels(userID string, body labelledUtilization) (*platformclientv2.APIResponse, error) {
+	return p.callAPI(http.MethodPut, "/api/v2/routing/users/"+url.PathEscape(userID)+"/utilization", nil, body, nil)
+}
+
+// callAPI makes a Public API request with the routing API's configuration and decodes the response into result if it is not nil
+func (p *sdkRoutingProxy) callAPI(method string, path string, queryParams map[string]string, body interface{}, result interface{}) (*platformclientv2.APIResponse, error) {
+	api := p.RoutingApi
+	apiClient := &api.Configuration.APIClient
+
+	headerParams := make(map[string]string)
+	if queryParams == nil {
+		queryParams = make(map[string]string)
+	}
+	formParams := url.Values{}
+	var postFileName string
+	var fileBytes []byte
+
+	// oauth required
+	if api.Configuration.AccessToken != "" {
+		headerParams["Authorization"] = "Bearer " + api.Configuration.AccessToken
+	}
+	// add default headers if any
+	for key := range api.Configuration.DefaultHeader {
+		headerParams[key] = api.Configuration.DefaultHeader[key]
+	}
+
+	headerParams["Content-Type"] = "application/json"
+	headerParams["Accept"] = "application/json"
+
+	response, err := apiClient.CallAPI(api.Configuration.BasePath+path, method, body, headerParams, queryParams, formParams, postFileName, fileBytes)
+	if err != nil {
+		// Nothing special to do here, but do avoid processing the response
+	} else if response.Error != nil {
+		err = fmt.Errorf(response.ErrorMessage)
+	} else if result != nil {
+		err = json.Unmarshal([]byte(response.RawBody), result)
+	}
+	return response, err
+}
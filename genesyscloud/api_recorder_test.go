@@ -0,0 +1,399 @@
+package genesyscloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Environment variable to run acceptance tests against recorded API interactions. Valid values are "record" and "replay".
+	apiRecorderModeEnvVar = "GENESYSCLOUD_TEST_RECORDER"
+	apiRecorderModeRecord = "record"
+	apiRecorderModeReplay = "replay"
+
+	apiRecorderCassetteDir = "testdata/cassettes"
+	apiRecorderScrubbed    = "REDACTED"
+)
+
+var (
+	uuidPattern = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+
+	// JSON fields containing secrets are scrubbed from recorded request and response bodies
+	apiRecorderSecretPattern = regexp.MustCompile(`"(access_token|refresh_token|password|secret|clientSecret|client_secret|token)"\s*:\s*"[^"]*"`)
+)
+
+// apiRecorder is a local proxy for the Public API that records request and response pairs to a cassette file per test,
+// or replays them without access to an org. Tests generate random UUID names, so UUIDs in replayed responses
+// are mapped to the UUIDs sent in the matching requests.
+type apiRecorder struct {
+	server   *httptest.Server
+	mode     string
+	upstream string
+	dir      string
+
+	mu       sync.Mutex
+	cassette *apiCassette
+}
+
+type apiCassette struct {
+	name         string
+	Interactions []*apiInteraction `json:"interactions"`
+
+	used     []bool
+	uuidsMap map[string]string
+}
+
+type apiInteraction struct {
+	Method       string `json:"method"`
+	URL          string `json:"url"`
+	RequestBody  string `json:"request_body,omitempty"`
+	StatusCode   int    `json:"status_code"`
+	ContentType  string `json:"content_type,omitempty"`
+	ResponseBody string `json:"response_body,omitempty"`
+}
+
+func newAPIRecorder(mode string, upstream string, dir string) *apiRecorder {
+	recorder := &apiRecorder{
+		mode:     mode,
+		upstream: upstream,
+		dir:      dir,
+	}
+	recorder.server = httptest.NewServer(http.HandlerFunc(recorder.handle))
+	return recorder
+}
+
+var (
+	sharedAPIRecorder     *apiRecorder
+	sharedAPIRecorderOnce sync.Once
+)
+
+// setupAPIRecorder routes SDK requests through a shared recorder when the recorder env var is set.
+// Requests for the test are recorded to or replayed from the test's cassette.
+func setupAPIRecorder(t *testing.T) {
+	mode := os.Getenv(apiRecorderModeEnvVar)
+	if mode == "" {
+		return
+	}
+	if mode != apiRecorderModeRecord && mode != apiRecorderModeReplay {
+		t.Fatalf("Invalid %s value %s. Must be %s or %s.", apiRecorderModeEnvVar, mode, apiRecorderModeRecord, apiRecorderModeReplay)
+	}
+
+	if mode == apiRecorderModeReplay {
+		// Credentials are not used when replaying
+		if v := os.Getenv("GENESYSCLOUD_OAUTHCLIENT_ID"); v == "" {
+			os.Setenv("GENESYSCLOUD_OAUTHCLIENT_ID", "replay-client-id")
+		}
+		if v := os.Getenv("GENESYSCLOUD_OAUTHCLIENT_SECRET"); v == "" {
+			os.Setenv("GENESYSCLOUD_OAUTHCLIENT_SECRET", "replay-client-secret")
+		}
+	}
+
+	sharedAPIRecorderOnce.Do(func() {
+		sharedAPIRecorder = newAPIRecorder(mode, getRegionBasePath(os.Getenv("GENESYSCLOUD_REGION")), apiRecorderCassetteDir)
+		apiBasePathOverride = sharedAPIRecorder.server.URL
+	})
+	sharedAPIRecorder.useCassette(t)
+}
+
+// useCassette loads the cassette for a test in replay mode, or starts a new cassette that is saved if the test passes in record mode
+func (r *apiRecorder) useCassette(t *testing.T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := strings.ReplaceAll(t.Name(), "/", "_")
+	if r.cassette != nil && r.cassette.name == name {
+		return
+	}
+
+	cassette := &apiCassette{
+		name:     name,
+		uuidsMap: make(map[string]string),
+	}
+	if r.mode == apiRecorderModeReplay {
+		data, err := ioutil.ReadFile(r.cassettePath(name))
+		if err != nil {
+			t.Fatalf("Failed to load cassette for %s. Run the test with %s=%s to record it: %v", name, apiRecorderModeEnvVar, apiRecorderModeRecord, err)
+		}
+		if err := json.Unmarshal(data, cassette); err != nil {
+			t.Fatalf("Failed to parse cassette for %s: %v", name, err)
+		}
+		cassette.used = make([]bool, len(cassette.Interactions))
+	} else {
+		t.Cleanup(func() {
+			if t.Failed() {
+				return
+			}
+			if err := r.saveCassette(cassette); err != nil {
+				t.Errorf("Failed to save cassette for %s: %v", name, err)
+			}
+		})
+	}
+	r.cassette = cassette
+}
+
+func (r *apiRecorder) cassettePath(name string) string {
+	return filepath.Join(r.dir, name+".json")
+}
+
+func (r *apiRecorder) saveCassette(cassette *apiCassette) error {
+	r.mu.Lock()
+	data, err := json.MarshalIndent(cassette, "", "\t")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(r.dir, os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.cassettePath(cassette.name), data, 0644)
+}
+
+func (r *apiRecorder) handle(w http.ResponseWriter, req *http.Request) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		writeFakeAPIError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+
+	if r.mode == apiRecorderModeReplay {
+		r.replay(w, req, body)
+	} else {
+		r.record(w, req, body)
+	}
+}
+
+func (r *apiRecorder) record(w http.ResponseWriter, req *http.Request, body []byte) {
+	upstream := r.upstream
+	if req.URL.Path == "/oauth/token" {
+		upstream = strings.Replace(upstream, "//api.", "//login.", 1)
+	}
+
+	upstreamReq, err := http.NewRequest(req.Method, upstream+req.URL.RequestURI(), bytes.NewReader(body))
+	if err != nil {
+		writeFakeAPIError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+	upstreamReq.Header = req.Header.Clone()
+
+	resp, err := http.DefaultClient.Do(upstreamReq)
+	if err != nil {
+		writeFakeAPIError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+	defer resp.Body.Close()
+	respBody, _ := ioutil.ReadAll(resp.Body)
+
+	// Tokens are requested when the SDK client pool is initialized, which is not specific to a test
+	if req.URL.Path != "/oauth/token" {
+		r.mu.Lock()
+		if r.cassette != nil {
+			r.cassette.Interactions = append(r.cassette.Interactions, &apiInteraction{
+				Method:       req.Method,
+				URL:          normalizeRecordedURL(req.URL),
+				RequestBody:  normalizeRecordedBody(body),
+				StatusCode:   resp.StatusCode,
+				ContentType:  resp.Header.Get("Content-Type"),
+				ResponseBody: scrubRecordedSecrets(string(respBody)),
+			})
+		}
+		r.mu.Unlock()
+	}
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.WriteHeader(resp.StatusCode)
+	w.Write(respBody)
+}
+
+func (r *apiRecorder) replay(w http.ResponseWriter, req *http.Request, body []byte) {
+	if req.URL.Path == "/oauth/token" {
+		writeFakeAPIResponse(w, http.StatusOK, map[string]interface{}{
+			"access_token": apiRecorderScrubbed,
+			"token_type":   "bearer",
+			"expires_in":   86400,
+		})
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	live := &apiInteraction{
+		Method:      req.Method,
+		URL:         normalizeRecordedURL(req.URL),
+		RequestBody: normalizeRecordedBody(body),
+	}
+	if r.cassette == nil {
+		// 501 is not retried by the SDK
+		writeFakeAPIError(w, http.StatusNotImplemented, "no cassette loaded for "+live.Method+" "+live.URL)
+		return
+	}
+
+	interaction := r.cassette.match(live)
+	if interaction == nil {
+		writeFakeAPIError(w, http.StatusNotImplemented, fmt.Sprintf("no recorded interaction in %s for %s %s", r.cassette.name, live.Method, live.URL))
+		return
+	}
+
+	// Map UUIDs from the recorded request to the UUIDs in this request, e.g. randomly generated names
+	recordedUUIDs := uuidPattern.FindAllString(interaction.URL+interaction.RequestBody, -1)
+	liveUUIDs := uuidPattern.FindAllString(live.URL+live.RequestBody, -1)
+	if len(recordedUUIDs) == len(liveUUIDs) {
+		for i, recordedUUID := range recordedUUIDs {
+			if recordedUUID != liveUUIDs[i] {
+				r.cassette.uuidsMap[recordedUUID] = liveUUIDs[i]
+			}
+		}
+	}
+
+	responseBody := uuidPattern.ReplaceAllStringFunc(interaction.ResponseBody, func(recordedUUID string) string {
+		if liveUUID, ok := r.cassette.uuidsMap[recordedUUID]; ok {
+			return liveUUID
+		}
+		return recordedUUID
+	})
+
+	if interaction.ContentType != "" {
+		w.Header().Set("Content-Type", interaction.ContentType)
+	}
+	w.WriteHeader(interaction.StatusCode)
+	w.Write([]byte(responseBody))
+}
+
+// match returns the first unused recorded interaction for the request. Requests are matched with UUIDs removed.
+// If all matching interactions have been used, the last one is returned again to support polling for changes.
+func (c *apiCassette) match(live *apiInteraction) *apiInteraction {
+	liveKey := live.matchKey()
+	var lastMatch *apiInteraction
+	for i, interaction := range c.Interactions {
+		if interaction.matchKey() != liveKey {
+			continue
+		}
+		if !c.used[i] {
+			c.used[i] = true
+			return interaction
+		}
+		lastMatch = interaction
+	}
+	return lastMatch
+}
+
+func (i *apiInteraction) matchKey() string {
+	return uuidPattern.ReplaceAllString(i.Method+" "+i.URL+" "+i.RequestBody, "<uuid>")
+}
+
+func normalizeRecordedURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.Path
+	}
+	// Encode sorts the query by key
+	return u.Path + "?" + u.Query().Encode()
+}
+
+func normalizeRecordedBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		// Marshal sorts the keys of JSON objects
+		if normalized, err := json.Marshal(parsed); err == nil {
+			body = normalized
+		}
+	}
+	return scrubRecordedSecrets(string(body))
+}
+
+func scrubRecordedSecrets(body string) string {
+	return apiRecorderSecretPattern.ReplaceAllString(body, `"$1":"`+apiRecorderScrubbed+`"`)
+}
+
+func TestUnitAPIRecorderReplay(t *testing.T) {
+	fakeAPI := newFakeGenesysCloudAPI()
+	defer fakeAPI.server.Close()
+	cassetteDir := t.TempDir()
+
+	recorder := newAPIRecorder(apiRecorderModeRecord, fakeAPI.server.URL, cassetteDir)
+	defer recorder.server.Close()
+	recorder.useCassette(t)
+
+	recordedName := "Terraform Skill " + uuid.NewString()
+	status, created := testAPIRecorderRequest(t, recorder, http.MethodPost, "/api/v2/routing/skills", map[string]interface{}{"name": recordedName})
+	if status != http.StatusOK || created["name"] != recordedName {
+		t.Fatalf("Unexpected response recording skill creation: %d %v", status, created)
+	}
+	skillID := created["id"].(string)
+	testAPIRecorderRequest(t, recorder, http.MethodGet, "/api/v2/routing/skills/"+skillID, nil)
+	testAPIRecorderRequest(t, recorder, http.MethodPost, "/api/v2/users", map[string]interface{}{"email": "user@example.com", "password": "secret-password"})
+	if err := recorder.saveCassette(recorder.cassette); err != nil {
+		t.Fatalf("Failed to save cassette: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(recorder.cassettePath(recorder.cassette.name))
+	if err != nil {
+		t.Fatalf("Failed to read cassette: %v", err)
+	}
+	if strings.Contains(string(data), "secret-password") {
+		t.Fatal("Secrets were not scrubbed from the cassette")
+	}
+
+	replayer := newAPIRecorder(apiRecorderModeReplay, "", cassetteDir)
+	defer replayer.server.Close()
+	replayer.useCassette(t)
+
+	// Generated UUID names in requests are substituted into the recorded responses
+	replayName := "Terraform Skill " + uuid.NewString()
+	status, created = testAPIRecorderRequest(t, replayer, http.MethodPost, "/api/v2/routing/skills", map[string]interface{}{"name": replayName})
+	if status != http.StatusOK || created["name"] != replayName || created["id"] != skillID {
+		t.Fatalf("Unexpected response replaying skill creation: %d %v", status, created)
+	}
+	status, read := testAPIRecorderRequest(t, replayer, http.MethodGet, "/api/v2/routing/skills/"+skillID, nil)
+	if status != http.StatusOK || read["name"] != replayName {
+		t.Fatalf("Unexpected response replaying skill read: %d %v", status, read)
+	}
+
+	// Repeated requests return the last matching interaction
+	if status, _ = testAPIRecorderRequest(t, replayer, http.MethodGet, "/api/v2/routing/skills/"+skillID, nil); status != http.StatusOK {
+		t.Fatalf("Expected repeated read to be replayed, got status %d", status)
+	}
+
+	if status, _ = testAPIRecorderRequest(t, replayer, http.MethodDelete, "/api/v2/routing/skills/"+skillID, nil); status != http.StatusNotImplemented {
+		t.Fatalf("Expected status %d for a request that was not recorded, got %d", http.StatusNotImplemented, status)
+	}
+}
+
+func testAPIRecorderRequest(t *testing.T, recorder *apiRecorder, method string, path string, body map[string]interface{}) (int, map[string]interface{}) {
+	var reqBody []byte
+	if body != nil {
+		reqBody, _ = json.Marshal(body)
+	}
+	req, err := http.NewRequest(method, recorder.server.URL+path, bytes.NewReader(reqBody))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+fakeAPIAccessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
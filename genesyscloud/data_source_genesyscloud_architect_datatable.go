@@ -44,6 +44,10 @@ func dataSourceArchitectDatatableRead(ctx context.Context, d *schema.ResourceDat
 			return resource.RetryableError(fmt.Errorf("No architect datatable found with name %s", name))
 		}
 
+		if err := checkImportMatches(ctx, len(*datatables.Entities), name); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		datatable := (*datatables.Entities)[0]
 		d.SetId(*datatable.Id)
 		return nil
@@ -44,6 +44,10 @@ func dataSourceScheduleGroupRead(ctx context.Context, d *schema.ResourceData, m
 			return resource.RetryableError(fmt.Errorf("No schedule groups found with name %s", name))
 		}
 
+		if err := checkImportMatches(ctx, len(*scheduleGroups.Entities), name); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		scheduleGroup := (*scheduleGroups.Entities)[0]
 		d.SetId(*scheduleGroup.Id)
 		return nil
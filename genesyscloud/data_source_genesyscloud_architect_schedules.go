@@ -43,6 +43,10 @@ func dataSourceScheduleRead(ctx context.Context, d *schema.ResourceData, m inter
 				return resource.RetryableError(fmt.Errorf("No schedule found with name %s", name))
 			}
 
+			if err := checkImportMatches(ctx, len(*schedule.Entities), name); err != nil {
+				return resource.NonRetryableError(err)
+			}
+
 			d.SetId(*(*schedule.Entities)[0].Id)
 			return nil
 		}
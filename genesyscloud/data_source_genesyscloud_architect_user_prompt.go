@@ -45,6 +45,10 @@ func dataSourceUserPromptRead(ctx context.Context, d *schema.ResourceData, m int
 			return resource.RetryableError(fmt.Errorf("No user prompts found with name %s", name))
 		}
 
+		if err := checkImportMatches(ctx, len(*prompts.Entities), name); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		prompt := (*prompts.Entities)[0]
 		d.SetId(*prompt.Id)
 
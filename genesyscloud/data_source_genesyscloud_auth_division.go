@@ -44,6 +44,10 @@ func dataSourceAuthDivisionRead(ctx context.Context, d *schema.ResourceData, m i
 			return resource.RetryableError(fmt.Errorf("No authorization divisions found with name %s", name))
 		}
 
+		if err := checkImportMatches(ctx, len(*divisions.Entities), name); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		division := (*divisions.Entities)[0]
 		d.SetId(*division.Id)
 		return nil
@@ -44,6 +44,10 @@ func dataSourceAuthRoleRead(ctx context.Context, d *schema.ResourceData, m inter
 			return resource.RetryableError(fmt.Errorf("No authorization roles found with name %s", name))
 		}
 
+		if err := checkImportMatches(ctx, len(*roles.Entities), name); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		role := (*roles.Entities)[0]
 		d.SetId(*role.Id)
 		return nil
@@ -44,6 +44,10 @@ func dataSourceFlowRead(ctx context.Context, d *schema.ResourceData, m interface
 			return resource.RetryableError(fmt.Errorf("No flows found with name %s", name))
 		}
 
+		if err := checkImportMatches(ctx, len(*flows.Entities), name); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		flow := (*flows.Entities)[0]
 		d.SetId(*flow.Id)
 		return nil
@@ -51,6 +51,10 @@ func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, m interfac
 			return resource.RetryableError(fmt.Errorf("No groups found with search criteria %v ", searchCriteria))
 		}
 
+		if err := checkImportMatches(ctx, len(*groups.Results), nameStr); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		// Select first group in the list
 		group := (*groups.Results)[0]
 		d.SetId(*group.Id)
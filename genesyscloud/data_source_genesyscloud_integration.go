@@ -31,6 +31,8 @@ func dataSourceIntegrationRead(ctx context.Context, d *schema.ResourceData, m in
 	integrationName := d.Get("name").(string)
 
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var matchID string
+		matches := 0
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 100
 			integrations, _, getErr := integrationAPI.GetIntegrations(pageSize, pageNum, "", nil, "", "")
@@ -40,17 +42,32 @@ func dataSourceIntegrationRead(ctx context.Context, d *schema.ResourceData, m in
 			}
 
 			if integrations.Entities == nil || len(*integrations.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("no integrations found with name: %s", integrationName))
+				break
 			}
 
 			for _, integration := range *integrations.Entities {
 				if  integration.Name != nil && *integration.Name ==  integrationName {
-					d.SetId(*integration.Id)
-					return nil
+					if matches == 0 {
+						matchID = *integration.Id
+					}
+					matches++
 				}
 			}
 
+			// Keep paging when importing by name so objects with the same name are found
+			if matches > 0 && !isImportLookup(ctx) {
+				break
+			}
+		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("no integrations found with name: %s", integrationName))
+		}
+		if err := checkImportMatches(ctx, matches, integrationName); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		d.SetId(matchID)
+		return nil
 	})
 
 }
@@ -31,6 +31,8 @@ func dataSourceIntegrationActionRead(ctx context.Context, d *schema.ResourceData
 	actionName := d.Get("name").(string)
 
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var matchID string
+		matches := 0
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 100
 			integrationAction, _, getErr := integrationAPI.GetIntegrationsActions(pageSize, pageNum, "", "", "", "", "", actionName, "", "")
@@ -40,16 +42,31 @@ func dataSourceIntegrationActionRead(ctx context.Context, d *schema.ResourceData
 			}
 
 			if integrationAction.Entities == nil || len(*integrationAction.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("no integration actions found with name: %s", actionName))
+				break
 			}
 
 			for _, action := range *integrationAction.Entities {
 				if action.Name != nil && *action.Name == actionName {
-					d.SetId(*action.Id)
-					return nil
+					if matches == 0 {
+						matchID = *action.Id
+					}
+					matches++
 				}
 			}
 
+			// Keep paging when importing by name so objects with the same name are found
+			if matches > 0 && !isImportLookup(ctx) {
+				break
+			}
+		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("no integration actions found with name: %s", actionName))
+		}
+		if err := checkImportMatches(ctx, matches, actionName); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		d.SetId(matchID)
+		return nil
 	})
 }
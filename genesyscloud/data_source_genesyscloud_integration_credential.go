@@ -31,6 +31,8 @@ func dataSourceIntegrationCredentialRead(ctx context.Context, d *schema.Resource
 	credName := d.Get("name").(string)
 
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var matchID string
+		matches := 0
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 100
 			integrationCredentials, _, getErr := integrationAPI.GetIntegrationsCredentials(pageNum, pageSize)
@@ -40,17 +42,32 @@ func dataSourceIntegrationCredentialRead(ctx context.Context, d *schema.Resource
 			}
 
 			if integrationCredentials.Entities == nil || len(*integrationCredentials.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("no integration credentials found with name: %s", credName))
+				break
 			}
 
 			for _, credential := range *integrationCredentials.Entities {
 				if credential.Name != nil && *credential.Name == credName {
-					d.SetId(*credential.Id)
-					return nil
+					if matches == 0 {
+						matchID = *credential.Id
+					}
+					matches++
 				}
 			}
 
+			// Keep paging when importing by name so objects with the same name are found
+			if matches > 0 && !isImportLookup(ctx) {
+				break
+			}
+		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("no integration credentials found with name: %s", credName))
+		}
+		if err := checkImportMatches(ctx, matches, credName); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		d.SetId(matchID)
+		return nil
 	})
 
 }
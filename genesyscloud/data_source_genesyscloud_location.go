@@ -51,6 +51,10 @@ func dataSourceLocationRead(ctx context.Context, d *schema.ResourceData, m inter
 			return resource.RetryableError(fmt.Errorf("No locations found with search criteria %v ", searchCriteria))
 		}
 
+		if err := checkImportMatches(ctx, len(*locations.Results), nameStr); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		// Select first location in the list
 		location := (*locations.Results)[0]
 		d.SetId(*location.Id)
@@ -33,23 +33,33 @@ func dataSourceOAuthClientRead(ctx context.Context, d *schema.ResourceData, m in
 
 	// Find first non-deleted oauth client by name. Retry in case new oauth client is not yet indexed by search
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
-		for pageNum := 1; ; pageNum++ {
-			oauths, _, getErr := oauthAPI.GetOauthClients()
-			if getErr != nil {
-				return resource.NonRetryableError(fmt.Errorf("Error requesting oauth client %s: %s", name, getErr))
-			}
-
-			if oauths.Entities == nil || len(*oauths.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("No oauth clients found with name %s", name))
-			}
+		// OAuth clients are not paged
+		oauths, _, getErr := oauthAPI.GetOauthClients()
+		if getErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error requesting oauth client %s: %s", name, getErr))
+		}
 
+		var matchID string
+		matches := 0
+		if oauths.Entities != nil {
 			for _, oauth := range *oauths.Entities {
 				if oauth.Name != nil && *oauth.Name == name &&
 					oauth.State != nil && *oauth.State != "deleted" {
-					d.SetId(*oauth.Id)
-					return nil
+					if matches == 0 {
+						matchID = *oauth.Id
+					}
+					matches++
 				}
 			}
 		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("No oauth clients found with name %s", name))
+		}
+		if err := checkImportMatches(ctx, matches, name); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		d.SetId(matchID)
+		return nil
 	})
 }
@@ -43,6 +43,10 @@ func dataSourceQualityFormsEvaluationsRead(ctx context.Context, d *schema.Resour
 				return resource.RetryableError(fmt.Errorf("No evaluation form found with name %s", name))
 			}
 
+			if err := checkImportMatches(ctx, len(*form.Entities), name); err != nil {
+				return resource.NonRetryableError(err)
+			}
+
 			d.SetId(*(*form.Entities)[0].Id)
 			return nil
 		}
@@ -33,26 +33,35 @@ func dataSourceRoutingEmailDomainRead(ctx context.Context, d *schema.ResourceDat
 	name := d.Get("name").(string)
 
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
-		for pageNum := 1; ; pageNum++ {
-			domains, _, getErr := routingAPI.GetRoutingEmailDomains()
+		// Email domains are not paged
+		domains, _, getErr := routingAPI.GetRoutingEmailDomains()
 
-			if getErr != nil {
-				return resource.NonRetryableError(fmt.Errorf("Error requesting email domain %s: %s", name, getErr))
-			}
-
-			//// No record found, keep trying for X seconds as this might an eventual consistency problem
-			if domains.Entities == nil || len(*domains.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("No email domains found with name %s", name))
-			}
+		if getErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error requesting email domain %s: %s", name, getErr))
+		}
 
-			// Once I get a result, cycle through until we find a name that matches
+		// Once I get a result, cycle through until we find a name that matches
+		var matchID string
+		matches := 0
+		if domains.Entities != nil {
 			for _, domain := range *domains.Entities {
 				if domain.Id != nil && *domain.Id == name {
-
-					d.SetId(*domain.Id)
-					return nil
+					if matches == 0 {
+						matchID = *domain.Id
+					}
+					matches++
 				}
 			}
 		}
+
+		//// No record found, keep trying for X seconds as this might an eventual consistency problem
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("No email domains found with name %s", name))
+		}
+		if err := checkImportMatches(ctx, matches, name); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		d.SetId(matchID)
+		return nil
 	})
 }
\ No newline at end of file
@@ -33,6 +33,8 @@ func dataSourceRoutingLanguageRead(ctx context.Context, d *schema.ResourceData,
 
 	// Find first non-deleted language by name. Retry in case new language is not yet indexed by search
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var matchID string
+		matches := 0
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 50
 			languages, _, getErr := routingAPI.GetRoutingLanguages(pageSize, pageNum, "", name, nil)
@@ -41,16 +43,32 @@ func dataSourceRoutingLanguageRead(ctx context.Context, d *schema.ResourceData,
 			}
 
 			if languages.Entities == nil || len(*languages.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("No routing languages found with name %s", name))
+				break
 			}
 
 			for _, language := range *languages.Entities {
 				if language.Name != nil && *language.Name == name &&
 					language.State != nil && *language.State != "deleted" {
-					d.SetId(*language.Id)
-					return nil
+					if matches == 0 {
+						matchID = *language.Id
+					}
+					matches++
 				}
 			}
+
+			// Keep paging when importing by name so objects with the same name are found
+			if matches > 0 && !isImportLookup(ctx) {
+				break
+			}
+		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("No routing languages found with name %s", name))
+		}
+		if err := checkImportMatches(ctx, matches, name); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		d.SetId(matchID)
+		return nil
 	})
 }
@@ -33,6 +33,8 @@ func dataSourceRoutingQueueRead(ctx context.Context, d *schema.ResourceData, m i
 
 	// Find first queue name. Retry in case new queue is not yet indexed by search
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var matchID string
+		matches := 0
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 100
 			queues, _, getErr := routingAPI.GetRoutingQueues(pageNum, pageSize, name, "", nil, nil)
@@ -41,15 +43,31 @@ func dataSourceRoutingQueueRead(ctx context.Context, d *schema.ResourceData, m i
 			}
 
 			if queues.Entities == nil || len(*queues.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("No routing queues found with name %s", name))
+				break
 			}
 
 			for _, queue := range *queues.Entities {
 				if queue.Name != nil && *queue.Name == name {
-					d.SetId(*queue.Id)
-					return nil
+					if matches == 0 {
+						matchID = *queue.Id
+					}
+					matches++
 				}
 			}
+
+			// Keep paging when importing by name so objects with the same name are found
+			if matches > 0 && !isImportLookup(ctx) {
+				break
+			}
+		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("No routing queues found with name %s", name))
+		}
+		if err := checkImportMatches(ctx, matches, name); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		d.SetId(matchID)
+		return nil
 	})
 }
@@ -33,6 +33,8 @@ func dataSourceRoutingSkillRead(ctx context.Context, d *schema.ResourceData, m i
 
 	// Find first non-deleted skill by name. Retry in case new skill is not yet indexed by search
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var matchID string
+		matches := 0
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 100
 			skills, _, getErr := routingAPI.GetRoutingSkills(pageSize, pageNum, name, nil)
@@ -41,16 +43,32 @@ func dataSourceRoutingSkillRead(ctx context.Context, d *schema.ResourceData, m i
 			}
 
 			if skills.Entities == nil || len(*skills.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("No routing skills found with name %s", name))
+				break
 			}
 
 			for _, skill := range *skills.Entities {
 				if skill.Name != nil && *skill.Name == name &&
 					skill.State != nil && *skill.State != "deleted" {
-					d.SetId(*skill.Id)
-					return nil
+					if matches == 0 {
+						matchID = *skill.Id
+					}
+					matches++
 				}
 			}
+
+			// Keep paging when importing by name so objects with the same name are found
+			if matches > 0 && !isImportLookup(ctx) {
+				break
+			}
+		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("No routing skills found with name %s", name))
+		}
+		if err := checkImportMatches(ctx, matches, name); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		d.SetId(matchID)
+		return nil
 	})
 }
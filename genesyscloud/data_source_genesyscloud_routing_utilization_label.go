@@ -0,0 +1,71 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRoutingUtilizationLabel() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for Genesys Cloud Routing Utilization Labels. Select a label by name.",
+		ReadContext: readWithPooledClient(dataSourceRoutingUtilizationLabelRead),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Label name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func dataSourceRoutingUtilizationLabelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	routingAPI := m.(*providerMeta).routingProxy()
+
+	name := d.Get("name").(string)
+
+	// Retry in case a new label is not yet indexed
+	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var matchID string
+		matches := 0
+		for pageNum := 1; ; pageNum++ {
+			const pageSize = 100
+			labels, _, getErr := routingAPI.GetRoutingUtilizationLabels(pageSize, pageNum, name)
+			if getErr != nil {
+				return resource.NonRetryableError(fmt.Errorf("Error requesting utilization label %s: %s", name, getErr))
+			}
+
+			if labels.Entities == nil || len(*labels.Entities) == 0 {
+				break
+			}
+
+			for _, label := range *labels.Entities {
+				if label.Name != nil && *label.Name == name {
+					if matches == 0 {
+						matchID = *label.Id
+					}
+					matches++
+				}
+			}
+
+			// Keep paging when importing by name so objects with the same name are found
+			if matches > 0 && !isImportLookup(ctx) {
+				break
+			}
+		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("No utilization labels found with name %s", name))
+		}
+		if err := checkImportMatches(ctx, matches, name); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		d.SetId(matchID)
+		return nil
+	})
+}
@@ -0,0 +1,47 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRoutingUtilizationLabel(t *testing.T) {
+	var (
+		labelResource   = "test-label"
+		labelDataSource = "test-label-data"
+		labelName       = "Terraform Label " + uuid.NewString()
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: generateRoutingUtilizationLabelResource(
+					labelResource,
+					labelName,
+				) + generateRoutingUtilizationLabelDataSource(labelDataSource, "genesyscloud_routing_utilization_label."+labelResource+".name", "genesyscloud_routing_utilization_label."+labelResource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.genesyscloud_routing_utilization_label."+labelDataSource, "id", "genesyscloud_routing_utilization_label."+labelResource, "id"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyRoutingUtilizationLabelsDestroyed,
+	})
+}
+
+func generateRoutingUtilizationLabelDataSource(
+	resourceID string,
+	name string,
+	// Must explicitly use depends_on in terraform v0.13 when a data source references a resource
+	// Fixed in v0.14 https://github.com/hashicorp/terraform/pull/26284
+	dependsOnResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_routing_utilization_label" "%s" {
+		name = %s
+		depends_on = [%s]
+	}
+	`, resourceID, name, dependsOnResource)
+}
@@ -42,6 +42,10 @@ func dataSourceRoutingWrapupcodeRead(ctx context.Context, d *schema.ResourceData
 				return resource.RetryableError(fmt.Errorf("No wrap-up code found with name %s", name))
 			}
 
+			if err := checkImportMatches(ctx, len(*wrapCode.Entities), name); err != nil {
+				return resource.NonRetryableError(err)
+			}
+
 			d.SetId(*(*wrapCode.Entities)[0].Id)
 			return nil
 		}
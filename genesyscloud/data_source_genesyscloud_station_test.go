@@ -28,6 +28,7 @@ func TestAccDataSourceStation(t *testing.T) {
 		stationDataRes = "station1234"
 	)
 
+	testAccPreCheck(t)
 	err := authorizeSdk()
 	if err != nil {
 		t.Fatal(err)
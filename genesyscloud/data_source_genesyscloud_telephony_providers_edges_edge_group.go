@@ -43,6 +43,10 @@ func dataSourceEdgeGroupRead(ctx context.Context, d *schema.ResourceData, m inte
 				return resource.RetryableError(fmt.Errorf("No edge group found with name %s", name))
 			}
 
+			if err := checkImportMatches(ctx, len(*edgeGroup.Entities), name); err != nil {
+				return resource.NonRetryableError(err)
+			}
+
 			d.SetId(*(*edgeGroup.Entities)[0].Id)
 			return nil
 		}
@@ -43,6 +43,10 @@ func dataSourcePhoneRead(ctx context.Context, d *schema.ResourceData, m interfac
 				return resource.RetryableError(fmt.Errorf("No phone found with name %s", name))
 			}
 
+			if err := checkImportMatches(ctx, len(*phone.Entities), name); err != nil {
+				return resource.NonRetryableError(err)
+			}
+
 			d.SetId(*(*phone.Entities)[0].Id)
 			return nil
 		}
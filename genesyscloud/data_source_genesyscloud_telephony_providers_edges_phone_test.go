@@ -26,6 +26,7 @@ func TestAccDataSourcePhone(t *testing.T) {
 		userDepartment = "Development"
 	)
 
+	testAccPreCheck(t)
 	err := authorizeSdk()
 	if err != nil {
 		t.Fatal(err)
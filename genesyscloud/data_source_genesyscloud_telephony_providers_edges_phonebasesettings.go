@@ -31,6 +31,8 @@ func dataSourcePhoneBaseSettingsRead(ctx context.Context, d *schema.ResourceData
 	name := d.Get("name").(string)
 
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var matchID string
+		matches := 0
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 50
 			phoneBaseSettings, _, getErr := edgesAPI.GetTelephonyProvidersEdgesPhonebasesettings(pageSize, pageNum, "", "", nil, name)
@@ -39,16 +41,32 @@ func dataSourcePhoneBaseSettingsRead(ctx context.Context, d *schema.ResourceData
 			}
 
 			if phoneBaseSettings.Entities == nil || len(*phoneBaseSettings.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("No phoneBaseSettings found with name %s", name))
+				break
 			}
 
 			for _, phoneBaseSetting := range *phoneBaseSettings.Entities {
 				if phoneBaseSetting.Name != nil && *phoneBaseSetting.Name == name &&
 					phoneBaseSetting.State != nil && *phoneBaseSetting.State != "deleted" {
-					d.SetId(*phoneBaseSetting.Id)
-					return nil
+					if matches == 0 {
+						matchID = *phoneBaseSetting.Id
+					}
+					matches++
 				}
 			}
+
+			// Keep paging when importing by name so objects with the same name are found
+			if matches > 0 && !isImportLookup(ctx) {
+				break
+			}
+		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("No phoneBaseSettings found with name %s", name))
+		}
+		if err := checkImportMatches(ctx, matches, name); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		d.SetId(matchID)
+		return nil
 	})
 }
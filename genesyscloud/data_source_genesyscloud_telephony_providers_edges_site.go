@@ -31,6 +31,8 @@ func dataSourceSiteRead(ctx context.Context, d *schema.ResourceData, m interface
 	name := d.Get("name").(string)
 
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var matchID string
+		matches := 0
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 50
 			sites, _, getErr := edgesAPI.GetTelephonyProvidersEdgesSites(pageSize, pageNum, "", "", name, "", false)
@@ -39,16 +41,32 @@ func dataSourceSiteRead(ctx context.Context, d *schema.ResourceData, m interface
 			}
 
 			if sites.Entities == nil || len(*sites.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("No sites found with name %s", name))
+				break
 			}
 
 			for _, site := range *sites.Entities {
 				if site.Name != nil && *site.Name == name &&
 					site.State != nil && *site.State != "deleted" {
-					d.SetId(*site.Id)
-					return nil
+					if matches == 0 {
+						matchID = *site.Id
+					}
+					matches++
 				}
 			}
+
+			// Keep paging when importing by name so objects with the same name are found
+			if matches > 0 && !isImportLookup(ctx) {
+				break
+			}
+		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("No sites found with name %s", name))
+		}
+		if err := checkImportMatches(ctx, matches, name); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		d.SetId(matchID)
+		return nil
 	})
 }
@@ -20,6 +20,7 @@ func TestAccDataSourceSite(t *testing.T) {
 		locationRes = "test-location1"
 	)
 
+	testAccPreCheck(t)
 	err := authorizeSdk()
 	if err != nil {
 		t.Fatal(err)
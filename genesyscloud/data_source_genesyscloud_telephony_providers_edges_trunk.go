@@ -31,6 +31,8 @@ func dataSourceTrunkRead(ctx context.Context, d *schema.ResourceData, m interfac
 	name := d.Get("name").(string)
 
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var matchID string
+		matches := 0
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 100
 			trunks, _, getErr := edgesAPI.GetTelephonyProvidersEdgesTrunks(pageNum, pageSize, "", "", "", "", "")
@@ -40,16 +42,32 @@ func dataSourceTrunkRead(ctx context.Context, d *schema.ResourceData, m interfac
 			}
 
 			if trunks.Entities == nil || len(*trunks.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("No trunk found with name %s", name))
+				break
 			}
 
 			for _, trunk := range *trunks.Entities {
 				if trunk.Name != nil && *trunk.Name == name &&
 					trunk.State != nil && *trunk.State != "deleted" {
-					d.SetId(*trunk.Id)
-					return nil
+					if matches == 0 {
+						matchID = *trunk.Id
+					}
+					matches++
 				}
 			}
+
+			// Keep paging when importing by name so objects with the same name are found
+			if matches > 0 && !isImportLookup(ctx) {
+				break
+			}
+		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("No trunk found with name %s", name))
+		}
+		if err := checkImportMatches(ctx, matches, name); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		d.SetId(matchID)
+		return nil
 	})
 }
@@ -31,6 +31,8 @@ func dataSourceTrunkBaseSettingsRead(ctx context.Context, d *schema.ResourceData
 	name := d.Get("name").(string)
 
 	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+		var matchID string
+		matches := 0
 		for pageNum := 1; ; pageNum++ {
 			const pageSize = 100
 			trunkBaseSettings, _, getErr := edgesAPI.GetTelephonyProvidersEdgesTrunkbasesettings(pageNum, pageSize, "", "", false, true, false, []string{"properties"}, name)
@@ -40,16 +42,32 @@ func dataSourceTrunkBaseSettingsRead(ctx context.Context, d *schema.ResourceData
 			}
 
 			if trunkBaseSettings.Entities == nil || len(*trunkBaseSettings.Entities) == 0 {
-				return resource.RetryableError(fmt.Errorf("No trunkBaseSettings found with name %s", name))
+				break
 			}
 
 			for _, trunkBaseSetting := range *trunkBaseSettings.Entities {
 				if trunkBaseSetting.Name != nil && *trunkBaseSetting.Name == name &&
 					trunkBaseSetting.State != nil && *trunkBaseSetting.State != "deleted" {
-					d.SetId(*trunkBaseSetting.Id)
-					return nil
+					if matches == 0 {
+						matchID = *trunkBaseSetting.Id
+					}
+					matches++
 				}
 			}
+
+			// Keep paging when importing by name so objects with the same name are found
+			if matches > 0 && !isImportLookup(ctx) {
+				break
+			}
+		}
+
+		if matches == 0 {
+			return resource.RetryableError(fmt.Errorf("No trunkBaseSettings found with name %s", name))
+		}
+		if err := checkImportMatches(ctx, matches, name); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		d.SetId(matchID)
+		return nil
 	})
 }
@@ -31,31 +31,40 @@ func dataSourceUser() *schema.Resource {
 }
 
 func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	sdkConfig := m.(*providerMeta).ClientConfig
-	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	usersAPI := m.(*providerMeta).usersProxy()
 
+	var field, value string
+	if email, ok := d.GetOk("email"); ok {
+		field, value = "email", email.(string)
+	} else if name, ok := d.GetOk("name"); ok {
+		field, value = "name", name.(string)
+	} else {
+		return diag.Errorf("No user search field specified")
+	}
+
+	userID, diagErr := searchUserID(ctx, usersAPI, field, value, 15*time.Second)
+	if diagErr != nil {
+		return diagErr
+	}
+	d.SetId(userID)
+	return nil
+}
+
+// searchUserID returns the ID of the first user with an exact match for a field, e.g. email.
+// The search is retried until the timeout in case the user is not yet indexed.
+func searchUserID(ctx context.Context, usersAPI usersProxy, field string, value string, timeout time.Duration) (string, diag.Diagnostics) {
 	exactSearchType := "EXACT"
 	sortOrderAsc := "ASC"
 	emailField := "email"
-	nameField := "name"
 
 	searchCriteria := platformclientv2.Usersearchcriteria{
 		VarType: &exactSearchType,
-	}
-	if email, ok := d.GetOk("email"); ok {
-		emailStr := email.(string)
-		searchCriteria.Fields = &[]string{emailField}
-		searchCriteria.Value = &emailStr
-	} else if name, ok := d.GetOk("name"); ok {
-		nameStr := name.(string)
-		searchCriteria.Fields = &[]string{nameField}
-		searchCriteria.Value = &nameStr
-	} else {
-		return diag.Errorf("No user search field specified")
+		Fields:  &[]string{field},
+		Value:   &value,
 	}
 
-	// Retry in case user is not yet indexed
-	return withRetries(ctx, 15*time.Second, func() *resource.RetryError {
+	var userID string
+	diagErr := withRetries(ctx, timeout, func() *resource.RetryError {
 		users, _, getErr := usersAPI.PostUsersSearch(platformclientv2.Usersearchrequest{
 			SortBy:    &emailField,
 			SortOrder: &sortOrderAsc,
@@ -69,9 +78,13 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 			return resource.RetryableError(fmt.Errorf("No users found with search criteria %v", searchCriteria))
 		}
 
+		if err := checkImportMatches(ctx, len(*users.Results), value); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		// Select first user in the list
-		user := (*users.Results)[0]
-		d.SetId(*user.Id)
+		userID = *(*users.Results)[0].Id
 		return nil
 	})
+	return userID, diagErr
 }
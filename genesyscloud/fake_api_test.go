@@ -0,0 +1,669 @@
+package genesyscloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+const fakeAPIAccessToken = "fake-access-token"
+
+// Collections served by the fake API mapped to the attribute that must be unique within the collection.
+// Objects in other paths under a collection (e.g. /api/v2/routing/queues/{id}/members) are stored as generic sub-resources.
+var fakeAPICollections = map[string]string{
+	"/api/v2/architect/prompts":               "name",
+	"/api/v2/architect/schedulegroups":        "name",
+	"/api/v2/architect/schedules":             "name",
+	"/api/v2/authorization/divisions":         "name",
+	"/api/v2/authorization/roles":             "name",
+	"/api/v2/flows":                           "name",
+	"/api/v2/flows/datatables":                "name",
+	"/api/v2/groups":                          "name",
+	"/api/v2/locations":                       "name",
+	"/api/v2/routing/languages":               "name",
+	"/api/v2/routing/queues":                  "name",
+	"/api/v2/routing/skills":                  "name",
+	"/api/v2/routing/wrapupcodes":             "name",
+	"/api/v2/stations":                        "name",
+	"/api/v2/telephony/providers/edges/sites": "name",
+	"/api/v2/users":                           "email",
+}
+
+// Collections where deleted objects remain readable with a "deleted" state, matching the Public API
+var fakeAPISoftDeleteCollections = map[string]bool{
+	"/api/v2/routing/skills": true,
+	"/api/v2/users":          true,
+}
+
+// fakeGenesysCloudAPI is an in-memory fake of the Genesys Cloud Public API endpoints used by the provider.
+// It supports paging, object versions, and 404/409 responses so resource CRUD functions and the exporter
+// can be tested without a live org.
+type fakeGenesysCloudAPI struct {
+	server *httptest.Server
+
+	mu             sync.Mutex
+	collections    map[string]*fakeAPICollection
+	subResources   map[string]interface{}
+	homeDivisionID string
+}
+
+type fakeAPICollection struct {
+	uniqueAttr string
+	entities   map[string]map[string]interface{}
+	order      []string
+}
+
+func newFakeGenesysCloudAPI() *fakeGenesysCloudAPI {
+	fake := &fakeGenesysCloudAPI{}
+	fake.reset()
+	fake.server = httptest.NewServer(http.HandlerFunc(fake.handle))
+	return fake
+}
+
+// reset removes all objects from the fake API and re-creates the home division
+func (f *fakeGenesysCloudAPI) reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.collections = make(map[string]*fakeAPICollection)
+	for path, uniqueAttr := range fakeAPICollections {
+		f.collections[path] = &fakeAPICollection{
+			uniqueAttr: uniqueAttr,
+			entities:   make(map[string]map[string]interface{}),
+		}
+	}
+	f.subResources = make(map[string]interface{})
+
+	home := f.collections["/api/v2/authorization/divisions"].add(map[string]interface{}{
+		"name":         "Home",
+		"homeDivision": true,
+	})
+	f.homeDivisionID = home["id"].(string)
+}
+
+// add stores a copy of an object in the collection and returns it
+func (c *fakeAPICollection) add(entity map[string]interface{}) map[string]interface{} {
+	stored := make(map[string]interface{}, len(entity)+2)
+	for k, v := range entity {
+		stored[k] = v
+	}
+	id := uuid.NewString()
+	stored["id"] = id
+	stored["version"] = 1
+	c.entities[id] = stored
+	c.order = append(c.order, id)
+	return stored
+}
+
+func (c *fakeAPICollection) remove(id string) {
+	delete(c.entities, id)
+	for i, orderedID := range c.order {
+		if orderedID == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
+
+func (c *fakeAPICollection) findConflict(entity map[string]interface{}, excludeID string) bool {
+	if c.uniqueAttr == "" || entity[c.uniqueAttr] == nil {
+		return false
+	}
+	for id, existing := range c.entities {
+		if id != excludeID && strings.EqualFold(fmt.Sprintf("%v", existing[c.uniqueAttr]), fmt.Sprintf("%v", entity[c.uniqueAttr])) {
+			return true
+		}
+	}
+	return false
+}
+
+// create adds an object directly to a collection, e.g. to seed data for a test
+func (f *fakeGenesysCloudAPI) create(collectionPath string, entity map[string]interface{}) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.collections[collectionPath].add(f.withDefaults(collectionPath, entity))
+}
+
+// get returns an object from a collection or nil if it does not exist
+func (f *fakeGenesysCloudAPI) get(collectionPath string, id string) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.collections[collectionPath].entities[id]
+}
+
+// update changes attributes of an existing object directly, e.g. to simulate changes made outside of Terraform
+func (f *fakeGenesysCloudAPI) update(collectionPath string, id string, attrs map[string]interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	entity := f.collections[collectionPath].entities[id]
+	for k, v := range attrs {
+		entity[k] = v
+	}
+	version, _ := strconv.Atoi(fmt.Sprintf("%v", entity["version"]))
+	entity["version"] = version + 1
+}
+
+func (f *fakeGenesysCloudAPI) withDefaults(collectionPath string, entity map[string]interface{}) map[string]interface{} {
+	if _, ok := entity["state"]; !ok {
+		entity["state"] = "active"
+	}
+	if collectionPath != "/api/v2/authorization/divisions" {
+		if _, ok := entity["division"]; !ok {
+			entity["division"] = map[string]interface{}{"id": f.homeDivisionID, "name": "Home"}
+		}
+		if divisionID, ok := entity["divisionId"].(string); ok && divisionID != "" {
+			entity["division"] = map[string]interface{}{"id": divisionID}
+		}
+	}
+	// Managers are updated by ID and read as users
+	if managerID, ok := entity["manager"].(string); ok {
+		if managerID == "" {
+			delete(entity, "manager")
+		} else {
+			entity["manager"] = map[string]interface{}{"id": managerID}
+		}
+	}
+	return entity
+}
+
+func (f *fakeGenesysCloudAPI) handle(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/oauth/token" {
+		writeFakeAPIResponse(w, http.StatusOK, map[string]interface{}{
+			"access_token": fakeAPIAccessToken,
+			"token_type":   "bearer",
+			"expires_in":   86400,
+		})
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+fakeAPIAccessToken {
+		writeFakeAPIError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	var body map[string]interface{}
+	if r.Body != nil {
+		if data, _ := ioutil.ReadAll(r.Body); len(data) > 0 {
+			if err := json.Unmarshal(data, &body); err != nil {
+				// Sub-resource bodies may be arrays, e.g. a list of members to add
+				var list []interface{}
+				if listErr := json.Unmarshal(data, &list); listErr != nil {
+					writeFakeAPIError(w, http.StatusBadRequest, "invalid request body")
+					return
+				}
+				body = map[string]interface{}{"entities": list}
+			}
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if strings.HasPrefix(r.URL.Path, "/api/v2/voicemail/userpolicies/") {
+		f.handleVoicemailUserPolicy(w, r, strings.TrimPrefix(r.URL.Path, "/api/v2/voicemail/userpolicies/"), body)
+		return
+	}
+	if strings.HasPrefix(r.URL.Path, "/api/v2/authorization/subjects/") {
+		f.handleAuthorizationSubject(w, r, strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v2/authorization/subjects/"), "/"), body)
+		return
+	}
+
+	collectionPath, collection := f.findCollection(r.URL.Path)
+	if collection == nil {
+		writeFakeAPIError(w, http.StatusNotFound, "resource not found: "+r.URL.Path)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, collectionPath), "/"), "/")
+	switch {
+	case parts[0] == "":
+		f.handleCollection(w, r, collectionPath, collection, body)
+	case parts[0] == "search" && r.Method == http.MethodPost:
+		f.handleSearch(w, collection, body)
+	case len(parts) == 1:
+		id := parts[0]
+		if id == "home" && collectionPath == "/api/v2/authorization/divisions" {
+			id = f.homeDivisionID
+		}
+		f.handleEntity(w, r, collectionPath, collection, id, body)
+	default:
+		if collection.entities[parts[0]] == nil {
+			writeFakeAPIError(w, http.StatusNotFound, "parent resource not found: "+parts[0])
+			return
+		}
+		if collectionPath == "/api/v2/users" && parts[1] == "station" {
+			f.handleUserStation(w, r, parts[0], parts[2:])
+			return
+		}
+		f.handleSubResource(w, r, body)
+	}
+}
+
+func (f *fakeGenesysCloudAPI) findCollection(path string) (string, *fakeAPICollection) {
+	var matchPath string
+	for collectionPath := range f.collections {
+		if (path == collectionPath || strings.HasPrefix(path, collectionPath+"/")) && len(collectionPath) > len(matchPath) {
+			matchPath = collectionPath
+		}
+	}
+	if matchPath == "" {
+		return "", nil
+	}
+	return matchPath, f.collections[matchPath]
+}
+
+func (f *fakeGenesysCloudAPI) handleCollection(w http.ResponseWriter, r *http.Request, collectionPath string, collection *fakeAPICollection, body map[string]interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		var entities []interface{}
+		name := r.URL.Query().Get("name")
+		var ids []string
+		if idParam := r.URL.Query().Get("id"); idParam != "" {
+			ids = strings.Split(idParam, ",")
+		}
+		for _, id := range collection.order {
+			entity := collection.entities[id]
+			if len(ids) > 0 && !stringInSlice(id, ids) {
+				continue
+			}
+			if name == "" || strings.EqualFold(fmt.Sprintf("%v", entity["name"]), strings.Trim(name, "*")) {
+				entities = append(entities, entity)
+			}
+		}
+		writeFakeAPIResponse(w, http.StatusOK, fakeAPIPage(r, entities))
+	case http.MethodPost:
+		if body == nil {
+			writeFakeAPIError(w, http.StatusBadRequest, "request body is required")
+			return
+		}
+		if collection.findConflict(body, "") {
+			writeFakeAPIError(w, http.StatusConflict, fmt.Sprintf("an object with %s %v already exists", collection.uniqueAttr, body[collection.uniqueAttr]))
+			return
+		}
+		writeFakeAPIResponse(w, http.StatusOK, collection.add(f.withDefaults(collectionPath, body)))
+	default:
+		writeFakeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (f *fakeGenesysCloudAPI) handleEntity(w http.ResponseWriter, r *http.Request, collectionPath string, collection *fakeAPICollection, id string, body map[string]interface{}) {
+	entity := collection.entities[id]
+	if entity == nil {
+		writeFakeAPIError(w, http.StatusNotFound, "resource not found: "+id)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeAPIResponse(w, http.StatusOK, entity)
+	case http.MethodPut, http.MethodPatch:
+		if version, ok := body["version"]; ok && fmt.Sprintf("%v", version) != fmt.Sprintf("%v", entity["version"]) {
+			writeFakeAPIError(w, http.StatusConflict, "The version supplied does not match the current version of the object")
+			return
+		}
+		if collection.findConflict(body, id) {
+			writeFakeAPIError(w, http.StatusConflict, fmt.Sprintf("an object with %s %v already exists", collection.uniqueAttr, body[collection.uniqueAttr]))
+			return
+		}
+
+		updated := make(map[string]interface{})
+		if r.Method == http.MethodPatch {
+			for k, v := range entity {
+				updated[k] = v
+			}
+		}
+		for k, v := range body {
+			updated[k] = v
+		}
+		updated = f.withDefaults(collectionPath, updated)
+		updated["id"] = id
+		version, _ := strconv.Atoi(fmt.Sprintf("%v", entity["version"]))
+		updated["version"] = version + 1
+		collection.entities[id] = updated
+		writeFakeAPIResponse(w, http.StatusOK, updated)
+	case http.MethodDelete:
+		if fakeAPISoftDeleteCollections[collectionPath] {
+			// Soft deletes return an empty object, e.g. DELETE /api/v2/users/{userId}
+			entity["state"] = "deleted"
+			writeFakeAPIResponse(w, http.StatusOK, map[string]interface{}{})
+			return
+		}
+		collection.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// Sub-resources are stored as the last body written to their path. Lists that have not been written are empty.
+func (f *fakeGenesysCloudAPI) handleSubResource(w http.ResponseWriter, r *http.Request, body map[string]interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		stored, ok := f.subResources[r.URL.Path]
+		if !ok {
+			writeFakeAPIResponse(w, http.StatusOK, fakeAPIPage(r, nil))
+			return
+		}
+		if storedMap, isMap := stored.(map[string]interface{}); isMap {
+			if entities, isList := storedMap["entities"].([]interface{}); isList && len(storedMap) == 1 {
+				writeFakeAPIResponse(w, http.StatusOK, fakeAPIPage(r, entities))
+				return
+			}
+		}
+		writeFakeAPIResponse(w, http.StatusOK, stored)
+	case http.MethodPut, http.MethodPatch:
+		f.subResources[r.URL.Path] = body
+		writeFakeAPIResponse(w, http.StatusOK, body)
+	case http.MethodPost:
+		writeFakeAPIResponse(w, http.StatusOK, body)
+	case http.MethodDelete:
+		delete(f.subResources, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// User stations are stored under /api/v2/users/{userId}/station. Like the Public API, new associations
+// are applied asynchronously and are not returned until the second read of the user's stations.
+func (f *fakeGenesysCloudAPI) handleUserStation(w http.ResponseWriter, r *http.Request, userID string, parts []string) {
+	path := "/api/v2/users/" + userID + "/station"
+	stations, _ := f.subResources[path].(map[string]interface{})
+	if stations == nil {
+		stations = make(map[string]interface{})
+		f.subResources[path] = stations
+	}
+
+	if len(parts) == 0 && r.Method == http.MethodGet {
+		result := make(map[string]interface{})
+		for _, key := range []string{"defaultStation", "associatedStation"} {
+			if station, ok := stations[key]; ok {
+				result[key] = station
+			}
+		}
+		if pending, ok := stations["pendingAssociatedStation"]; ok {
+			stations["associatedStation"] = pending
+			delete(stations, "pendingAssociatedStation")
+		}
+		if station, ok := result["associatedStation"]; ok {
+			result["effectiveStation"] = station
+		} else if station, ok := result["defaultStation"]; ok {
+			result["effectiveStation"] = station
+		}
+		writeFakeAPIResponse(w, http.StatusOK, result)
+		return
+	}
+
+	if len(parts) == 0 || (parts[0] != "defaultstation" && parts[0] != "associatedstation") {
+		writeFakeAPIError(w, http.StatusNotFound, "resource not found: "+r.URL.Path)
+		return
+	}
+	key := "defaultStation"
+	if parts[0] == "associatedstation" {
+		key = "pendingAssociatedStation"
+	}
+
+	switch {
+	case r.Method == http.MethodPut && len(parts) == 2:
+		station := f.collections["/api/v2/stations"].entities[parts[1]]
+		if station == nil {
+			writeFakeAPIError(w, http.StatusNotFound, "station not found: "+parts[1])
+			return
+		}
+		stations[key] = map[string]interface{}{"id": station["id"], "name": station["name"]}
+		w.WriteHeader(http.StatusAccepted)
+	case r.Method == http.MethodDelete && len(parts) == 1:
+		if key == "pendingAssociatedStation" {
+			delete(stations, "associatedStation")
+		}
+		delete(stations, key)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeFakeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// Voicemail policies exist for every user. Patches are merged into the policy and the PIN is never returned.
+func (f *fakeGenesysCloudAPI) handleVoicemailUserPolicy(w http.ResponseWriter, r *http.Request, userID string, body map[string]interface{}) {
+	if f.collections["/api/v2/users"].entities[userID] == nil {
+		writeFakeAPIError(w, http.StatusNotFound, "user not found: "+userID)
+		return
+	}
+
+	path := "/api/v2/voicemail/userpolicies/" + userID
+	policy, _ := f.subResources[path].(map[string]interface{})
+	if policy == nil {
+		policy = map[string]interface{}{
+			"enabled":                true,
+			"alertTimeoutSeconds":    30,
+			"sendEmailNotifications": true,
+		}
+		f.subResources[path] = policy
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPatch:
+		for k, v := range body {
+			policy[k] = v
+		}
+	default:
+		writeFakeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	result := make(map[string]interface{})
+	for k, v := range policy {
+		if k != "pin" {
+			result[k] = v
+		}
+	}
+	writeFakeAPIResponse(w, http.StatusOK, result)
+}
+
+// Role grants are stored as a list of grants under /api/v2/authorization/subjects/{subjectId}.
+// Grants may be added directly to the list to simulate grants made outside of Terraform, e.g. inherited from a group.
+func (f *fakeGenesysCloudAPI) handleAuthorizationSubject(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	subjectID := parts[0]
+	path := "/api/v2/authorization/subjects/" + subjectID
+	grants, _ := f.subResources[path].([]interface{})
+
+	switch {
+	case r.Method == http.MethodGet && len(parts) == 1:
+		writeFakeAPIResponse(w, http.StatusOK, map[string]interface{}{
+			"id":     subjectID,
+			"grants": grants,
+		})
+	case r.Method == http.MethodPost && len(parts) == 2 && parts[1] == "bulkadd":
+		newGrants, _ := body["grants"].([]interface{})
+		for _, g := range newGrants {
+			pair, _ := g.(map[string]interface{})
+			roleID := fmt.Sprintf("%v", pair["roleId"])
+			divisionID := fmt.Sprintf("%v", pair["divisionId"])
+			if f.collections["/api/v2/authorization/roles"].entities[roleID] == nil {
+				writeFakeAPIError(w, http.StatusNotFound, "role not found: "+roleID)
+				return
+			}
+			if divisionID != "*" && f.collections["/api/v2/authorization/divisions"].entities[divisionID] == nil {
+				writeFakeAPIError(w, http.StatusNotFound, "division not found: "+divisionID)
+				return
+			}
+			if findFakeAPIGrant(grants, subjectID, roleID, divisionID) < 0 {
+				grants = append(grants, newFakeAPIGrant(subjectID, roleID, divisionID))
+			}
+		}
+		f.subResources[path] = grants
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete && len(parts) == 5 && parts[1] == "divisions" && parts[3] == "roles":
+		i := findFakeAPIGrant(grants, subjectID, parts[4], parts[2])
+		if i < 0 {
+			writeFakeAPIError(w, http.StatusNotFound, "grant not found")
+			return
+		}
+		f.subResources[path] = append(grants[:i], grants[i+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func newFakeAPIGrant(subjectID string, roleID string, divisionID string) map[string]interface{} {
+	return map[string]interface{}{
+		"subjectId": subjectID,
+		"role":      map[string]interface{}{"id": roleID},
+		"division":  map[string]interface{}{"id": divisionID},
+	}
+}
+
+func findFakeAPIGrant(grants []interface{}, subjectID string, roleID string, divisionID string) int {
+	for i, g := range grants {
+		grant, _ := g.(map[string]interface{})
+		role, _ := grant["role"].(map[string]interface{})
+		division, _ := grant["division"].(map[string]interface{})
+		if grant["subjectId"] == subjectID && role["id"] == roleID && division["id"] == divisionID {
+			return i
+		}
+	}
+	return -1
+}
+
+// Supports exact match searches on a single field, e.g. users by email, with a single value or a list of values
+func (f *fakeGenesysCloudAPI) handleSearch(w http.ResponseWriter, collection *fakeAPICollection, body map[string]interface{}) {
+	var results []interface{}
+	queries, _ := body["query"].([]interface{})
+	for _, id := range collection.order {
+		entity := collection.entities[id]
+		matchesAll := true
+		for _, q := range queries {
+			query, _ := q.(map[string]interface{})
+			fields, _ := query["fields"].([]interface{})
+			values, _ := query["values"].([]interface{})
+			if value, ok := query["value"]; ok {
+				values = append(values, value)
+			}
+			matchesField := false
+			for _, field := range fields {
+				for _, value := range values {
+					if strings.EqualFold(fmt.Sprintf("%v", entity[fmt.Sprintf("%v", field)]), fmt.Sprintf("%v", value)) {
+						matchesField = true
+					}
+				}
+			}
+			matchesAll = matchesAll && matchesField
+		}
+		if matchesAll {
+			results = append(results, entity)
+		}
+	}
+	writeFakeAPIResponse(w, http.StatusOK, map[string]interface{}{
+		"total":   len(results),
+		"results": results,
+	})
+}
+
+func fakeAPIPage(r *http.Request, entities []interface{}) map[string]interface{} {
+	pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if err != nil || pageSize < 1 {
+		pageSize = 25
+	}
+	pageNumber, err := strconv.Atoi(r.URL.Query().Get("pageNumber"))
+	if err != nil || pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	page := []interface{}{}
+	start := (pageNumber - 1) * pageSize
+	if start < len(entities) {
+		end := start + pageSize
+		if end > len(entities) {
+			end = len(entities)
+		}
+		page = entities[start:end]
+	}
+
+	return map[string]interface{}{
+		"entities":   page,
+		"pageSize":   pageSize,
+		"pageNumber": pageNumber,
+		"total":      len(entities),
+		"pageCount":  (len(entities) + pageSize - 1) / pageSize,
+	}
+}
+
+func writeFakeAPIResponse(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeFakeAPIError(w http.ResponseWriter, status int, message string) {
+	writeFakeAPIResponse(w, status, map[string]interface{}{
+		"message": message,
+		"code":    strings.ToLower(strings.ReplaceAll(http.StatusText(status), " ", ".")),
+		"status":  status,
+	})
+}
+
+var (
+	sharedFakeAPI     *fakeGenesysCloudAPI
+	sharedFakeAPIMeta *providerMeta
+	sharedFakeAPIOnce sync.Once
+)
+
+// setupFakeAPI configures the provider and SDK client pool to use a shared fake API and clears any existing objects.
+// The SDK client pool can only be initialized once per test run, so fake API tests are skipped when running acceptance tests.
+func setupFakeAPI(t *testing.T) (*fakeGenesysCloudAPI, *providerMeta) {
+	if os.Getenv(resource.TestEnvVar) != "" {
+		t.Skip("Fake API tests are skipped when running acceptance tests")
+	}
+
+	sharedFakeAPIOnce.Do(func() {
+		sharedFakeAPI = newFakeGenesysCloudAPI()
+		apiBasePathOverride = sharedFakeAPI.server.URL
+
+		provider := New("0.1.0")()
+		diagErr := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+			"oauthclient_id":     "fake-client-id",
+			"oauthclient_secret": "fake-client-secret",
+			"aws_region":         "us-east-1",
+		}))
+		if diagErr.HasError() {
+			t.Fatalf("Failed to configure provider for the fake API: %v", diagErr)
+		}
+		sharedFakeAPIMeta = provider.Meta().(*providerMeta)
+	})
+
+	if sharedFakeAPIMeta == nil || platformclientv2.GetDefaultConfiguration().BasePath != sharedFakeAPI.server.URL {
+		t.Fatal("The SDK was initialized before the fake API was configured")
+	}
+
+	sharedFakeAPI.reset()
+	return sharedFakeAPI, sharedFakeAPIMeta
+}
+
+// sortedNames returns the sorted names of all objects in a fake API collection
+func (f *fakeGenesysCloudAPI) sortedNames(collectionPath string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var names []string
+	for _, entity := range f.collections[collectionPath].entities {
+		names = append(names, fmt.Sprintf("%v", entity["name"]))
+	}
+	sort.Strings(names)
+	return names
+}
@@ -34,6 +34,12 @@ func New(version string) func() *schema.Provider {
 	return func() *schema.Provider {
 		return &schema.Provider{
 			Schema: map[string]*schema.Schema{
+				"deletion_protection": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_DELETION_PROTECTION", false),
+					Description: "Default for the `deletion_protection` attribute of high-impact resources such as queues, sites, and divisions. Protected objects cannot be deleted by Terraform. Can be set with the `GENESYSCLOUD_DELETION_PROTECTION` environment variable.",
+				},
 				"oauthclient_id": {
 					Type:        schema.TypeString,
 					Required:    true,
@@ -47,6 +53,12 @@ func New(version string) func() *schema.Provider {
 					Description: "OAuthClient secret found on the OAuth page of Admin UI. Can be set with the `GENESYSCLOUD_OAUTHCLIENT_SECRET` environment variable.",
 					Sensitive:   true,
 				},
+				"adopt_existing": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_ADOPT_EXISTING", false),
+					Description: "When creating a queue, skill, wrap-up code, language, or division fails because an object with the same name already exists, manage the existing object instead and update it to match the configuration. Can be set with the `GENESYSCLOUD_ADOPT_EXISTING` environment variable.",
+				},
 				"aws_region": {
 					Type:         schema.TypeString,
 					Required:     true,
@@ -67,6 +79,18 @@ func New(version string) func() *schema.Provider {
 					Description:  "Max number of OAuth tokens in the token pool. Can be set with the `GENESYSCLOUD_TOKEN_POOL_SIZE` environment variable.",
 					ValidateFunc: validation.IntBetween(1, 20),
 				},
+				"read_only": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_READ_ONLY", false),
+					Description: "Prevents the provider from creating, updating, or deleting any objects. Resources can still be read, e.g. to detect drift with `terraform plan`. Can be set with the `GENESYSCLOUD_READ_ONLY` environment variable.",
+				},
+				"validate_references": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_VALIDATE_REFERENCES", false),
+					Description: "Check during plan that IDs referenced by resources exist and have the right type, e.g. that a queue's flow is an in-queue flow. This makes additional API requests when references change. Can be set with the `GENESYSCLOUD_VALIDATE_REFERENCES` environment variable.",
+				},
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				"genesyscloud_architect_datatable":                         resourceArchitectDatatable(),
@@ -78,6 +102,7 @@ func New(version string) func() *schema.Provider {
 				"genesyscloud_auth_role":                                   resourceAuthRole(),
 				"genesyscloud_auth_division":                               resourceAuthDivision(),
 				"genesyscloud_group":                                       resourceGroup(),
+				"genesyscloud_group_role":                                  resourceGroupRole(),
 				"genesyscloud_group_roles":                                 resourceGroupRoles(),
 				"genesyscloud_idp_adfs":                                    resourceIdpAdfs(),
 				"genesyscloud_idp_generic":                                 resourceIdpGeneric(),
@@ -96,8 +121,11 @@ func New(version string) func() *schema.Provider {
 				"genesyscloud_routing_email_route":                         resourceRoutingEmailRoute(),
 				"genesyscloud_routing_language":                            resourceRoutingLanguage(),
 				"genesyscloud_routing_queue":                               resourceRoutingQueue(),
+				"genesyscloud_routing_queue_conditional_group_routing":     resourceRoutingQueueConditionalGroupRouting(),
+				"genesyscloud_routing_queue_member":                        resourceRoutingQueueMember(),
 				"genesyscloud_routing_skill":                               resourceRoutingSkill(),
 				"genesyscloud_routing_utilization":                         resourceRoutingUtilization(),
+				"genesyscloud_routing_utilization_label":                   resourceRoutingUtilizationLabel(),
 				"genesyscloud_routing_wrapupcode":                          resourceRoutingWrapupCode(),
 				"genesyscloud_telephony_providers_edges_did_pool":          resourceTelephonyDidPool(),
 				"genesyscloud_telephony_providers_edges_edge_group":        resourceEdgeGroup(),
@@ -108,7 +136,10 @@ func New(version string) func() *schema.Provider {
 				"genesyscloud_telephony_providers_edges_trunk":             resourceTrunk(),
 				"genesyscloud_tf_export":                                   resourceTfExport(),
 				"genesyscloud_user":                                        resourceUser(),
+				"genesyscloud_user_role":                                   resourceUserRole(),
 				"genesyscloud_user_roles":                                  resourceUserRoles(),
+				"genesyscloud_user_station":                                resourceUserStation(),
+				"genesyscloud_users_bulk":                                  resourceUsersBulk(),
 			},
 			DataSourcesMap: map[string]*schema.Resource{
 				"genesyscloud_architect_datatable":                         dataSourceArchitectDatatable(),
@@ -128,6 +159,7 @@ func New(version string) func() *schema.Provider {
 				"genesyscloud_routing_language":                            dataSourceRoutingLanguage(),
 				"genesyscloud_routing_queue":                               dataSourceRoutingQueue(),
 				"genesyscloud_routing_skill":                               dataSourceRoutingSkill(),
+				"genesyscloud_routing_utilization_label":                   dataSourceRoutingUtilizationLabel(),
 				"genesyscloud_routing_email_domain":                        dataSourceRoutingEmailDomain(),
 				"genesyscloud_routing_wrapupcode":                          dataSourceRoutingWrapupcode(),
 				"genesyscloud_script":                                      dataSourceScript(),
@@ -152,6 +184,21 @@ type providerMeta struct {
 	Version      string
 	ClientConfig *platformclientv2.Configuration
 	Domain       string
+
+	// Validate referenced IDs during plan
+	ValidateReferences bool
+
+	// Refuse to make any changes to the org
+	ReadOnly bool
+
+	// Default deletion protection for resources that support it
+	DeletionProtection bool
+
+	// Adopt existing objects with the same name when create fails
+	AdoptExisting bool
+
+	// Overrides the API proxies used by resources, e.g. with fakes in unit tests
+	proxies *apiProxies
 }
 
 func configure(version string) schema.ConfigureContextFunc {
@@ -162,9 +209,13 @@ func configure(version string) schema.ConfigureContextFunc {
 			return nil, err
 		}
 		return &providerMeta{
-			Version:      version,
-			ClientConfig: platformclientv2.GetDefaultConfiguration(),
-			Domain:       getRegionDomain(data.Get("aws_region").(string)),
+			Version:            version,
+			ClientConfig:       platformclientv2.GetDefaultConfiguration(),
+			Domain:             getRegionDomain(data.Get("aws_region").(string)),
+			ValidateReferences: data.Get("validate_references").(bool),
+			ReadOnly:           data.Get("read_only").(bool),
+			DeletionProtection: data.Get("deletion_protection").(bool),
+			AdoptExisting:      data.Get("adopt_existing").(bool),
 		}, nil
 	}
 }
@@ -203,10 +254,21 @@ func getRegionBasePath(region string) string {
 	return "https://api." + getRegionDomain(region)
 }
 
+// Overrides the API base path for the configured region. This is only set by tests
+// to point the provider at a local fake or recorder of the API.
+var apiBasePathOverride string
+
+func getAPIBasePath(region string) string {
+	if apiBasePathOverride != "" {
+		return apiBasePathOverride
+	}
+	return getRegionBasePath(region)
+}
+
 func initClientConfig(data *schema.ResourceData, version string, config *platformclientv2.Configuration) diag.Diagnostics {
 	oauthclientID := data.Get("oauthclient_id").(string)
 	oauthclientSecret := data.Get("oauthclient_secret").(string)
-	basePath := getRegionBasePath(data.Get("aws_region").(string))
+	basePath := getAPIBasePath(data.Get("aws_region").(string))
 
 	config.BasePath = basePath
 	if data.Get("sdk_debug").(bool) {
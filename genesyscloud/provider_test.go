@@ -23,13 +23,16 @@ func TestProvider(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
+	if v := os.Getenv("GENESYSCLOUD_REGION"); v == "" {
+		os.Setenv("GENESYSCLOUD_REGION", "dca") // Default to dev environment
+	}
+	// Route requests through the recorder before credentials are checked, as they are not required for replay
+	setupAPIRecorder(t)
+
 	if v := os.Getenv("GENESYSCLOUD_OAUTHCLIENT_ID"); v == "" {
 		t.Fatal("Missing env GENESYSCLOUD_OAUTHCLIENT_ID")
 	}
 	if v := os.Getenv("GENESYSCLOUD_OAUTHCLIENT_SECRET"); v == "" {
 		t.Fatal("Missing env GENESYSCLOUD_OAUTHCLIENT_SECRET")
 	}
-	if v := os.Getenv("GENESYSCLOUD_REGION"); v == "" {
-		os.Setenv("GENESYSCLOUD_REGION", "dca") // Default to dev environment
-	}
 }
@@ -10,12 +10,16 @@ import (
 	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type ResourceMeta struct {
 	// Name of the resoruce to be used in exports
 	Name string
 
+	// Name of the resource before it was sanitized. This is set by sanitizeResourceNames
+	OriginalName string
+
 	// Prefix to add to the ID when reading state
 	IdPrefix string
 }
@@ -26,6 +30,12 @@ type ResourceIDMetaMap map[string]*ResourceMeta
 // GetAllResourcesFunc is a method that returns all resource IDs
 type GetAllResourcesFunc func(context.Context) (ResourceIDMetaMap, diag.Diagnostics)
 
+// ExportFilesFunc is a method that writes any local files needed by an exported resource to the export directory.
+// The resource's config map may be updated to reference the written files. Files should be named using the exported
+// resource name, which is unique within the resource type. The paths of the written files relative to the export directory
+// are returned so they can be listed in the export manifest.
+type ExportFilesFunc func(ctx context.Context, id string, resourceName string, configMap jsonMap, exportDir string, meta interface{}) ([]string, diag.Diagnostics)
+
 // RefAttrSettings contains behavior settings for references
 type RefAttrSettings struct {
 
@@ -34,6 +44,32 @@ type RefAttrSettings struct {
 
 	// Values that may be set that should not be treated as IDs
 	AltValues []string
+
+	// Optional attribute that is set instead of the reference when the referenced object is not exported,
+	// e.g. a manager's email instead of their user ID. Only supported for top-level attributes.
+	FallbackAttr string
+
+	// Method to get the value of FallbackAttr from the referenced object's ID
+	FallbackValueFunc func(ctx context.Context, id string, meta interface{}) (string, diag.Diagnostics)
+
+	// Optional sibling attribute that decides whether a value is a reference, e.g. the type of a member group.
+	// The value is only treated as a reference when the sibling attribute is set to one of ConditionValues.
+	// Other values are kept as-is. Only supported for attributes in nested objects.
+	ConditionAttr   string
+	ConditionValues []string
+
+	// Keep the ID when the referenced object is not exported instead of removing it from the config.
+	// This is used when removing the value would change the meaning of the config, e.g. in ordered rules.
+	KeepUnresolved bool
+}
+
+// appliesTo returns true if a value in the config map should be treated as a reference
+func (s *RefAttrSettings) appliesTo(configMap map[string]interface{}) bool {
+	if s.ConditionAttr == "" {
+		return true
+	}
+	condition, _ := configMap[s.ConditionAttr].(string)
+	return stringInSlice(condition, s.ConditionValues)
 }
 
 // ResourceExporter is an interface to implement for resources that can be exported
@@ -49,18 +85,26 @@ type ResourceExporter struct {
 	RefAttrs map[string]*RefAttrSettings
 
 	// AllowZeroValues is a list of attributes that should allow zero values in the export.
-	// By default zero values are removed from the config due to lack of "null" support in the plugin SDK
+	// By default zero values are removed from the config due to lack of "null" support in the plugin SDK,
+	// unless the resource schema indicates the zero value is meaningful (see keepZeroValue)
 	AllowZeroValues []string
 
 	// RemoveIfMissing is a map of attributes to a list of inner object attributes.
 	// When all specified inner attributes are missing from an object, that object is removed
 	RemoveIfMissing map[string][]string
 
+	// Optional method to export files referenced by a resource's config, e.g. audio files for prompts.
+	// File paths set in the config should be relative to the export directory
+	ExportFilesFunc ExportFilesFunc
+
 	// Map of resource id->names. This is set after a call to loadSanitizedResourceMap
 	SanitizedResourceMap ResourceIDMetaMap
 
 	// List of attributes to exclude from config. This is set by the export configuration.
 	ExcludedAttributes []string
+
+	// Schema of the exported resource. This is set from the provider's resource definition during an export.
+	ResourceSchema map[string]*schema.Schema
 }
 
 func (r *ResourceExporter) loadSanitizedResourceMap(ctx context.Context, name string, filter []string) diag.Diagnostics {
@@ -105,10 +149,89 @@ func (r *ResourceExporter) getRefAttrSettings(attribute string) *RefAttrSettings
 	return r.RefAttrs[attribute]
 }
 
+// isExported returns true if an object is in the export's sanitized resource map for its type
+func isExported(exporters map[string]*ResourceExporter, resType string, id string) bool {
+	exporter := exporters[resType]
+	return exporter != nil && exporter.SanitizedResourceMap != nil && exporter.SanitizedResourceMap[id] != nil
+}
+
 func (r *ResourceExporter) allowZeroValues(attribute string) bool {
 	return stringInSlice(attribute, r.AllowZeroValues)
 }
 
+// getAttributeSchema returns the schema for an attribute path. Attributes in nested objects are separated by a '.'
+func (r *ResourceExporter) getAttributeSchema(attribute string) *schema.Schema {
+	schemaMap := r.ResourceSchema
+	var attrSchema *schema.Schema
+	for _, attr := range strings.Split(attribute, ".") {
+		if schemaMap == nil {
+			return nil
+		}
+		attrSchema = schemaMap[attr]
+		if attrSchema == nil {
+			return nil
+		}
+		schemaMap = nil
+		if elem, ok := attrSchema.Elem.(*schema.Resource); ok {
+			schemaMap = elem.Schema
+		}
+	}
+	return attrSchema
+}
+
+// isComputedOnly returns true if the attribute is set by the API and cannot be set in config
+func (r *ResourceExporter) isComputedOnly(attribute string) bool {
+	attrSchema := r.getAttributeSchema(attribute)
+	return attrSchema != nil && attrSchema.Computed && !attrSchema.Optional && !attrSchema.Required
+}
+
+// keepZeroValue returns true if the schema indicates a zero value for the attribute is meaningful.
+// Zero values are kept for required attributes, and for optional attributes that accept a zero value
+// when it differs from the default or the attribute is numeric with no default.
+func (r *ResourceExporter) keepZeroValue(attribute string) bool {
+	attrSchema := r.getAttributeSchema(attribute)
+	if attrSchema == nil {
+		return false
+	}
+
+	if attrSchema.Required {
+		return true
+	}
+
+	var zeroValue interface{}
+	switch attrSchema.Type {
+	case schema.TypeInt:
+		zeroValue = 0
+	case schema.TypeFloat:
+		zeroValue = float64(0)
+	case schema.TypeString:
+		zeroValue = ""
+	default:
+		return false
+	}
+
+	if attrSchema.Default != nil && fmt.Sprintf("%v", attrSchema.Default) == fmt.Sprintf("%v", zeroValue) {
+		// Zero value is the default
+		return false
+	}
+	if attrSchema.DefaultFunc != nil {
+		// The default cannot be determined without calling the default function
+		return false
+	}
+	if attrSchema.Type == schema.TypeString && attrSchema.Default == nil {
+		// Empty strings are treated as unset
+		return false
+	}
+
+	// Zero values must be explicit in the config if there is a non-zero default.
+	// Otherwise they are kept if they are valid for the attribute.
+	if attrSchema.ValidateFunc != nil {
+		_, errs := attrSchema.ValidateFunc(zeroValue, attribute)
+		return len(errs) == 0
+	}
+	return true
+}
+
 func (r *ResourceExporter) addExcludedAttribute(attribute string) {
 	r.ExcludedAttributes = append(r.ExcludedAttributes, attribute)
 }
@@ -168,8 +291,10 @@ func getResourceExporters(filter []string) map[string]*ResourceExporter {
 		"genesyscloud_routing_email_route":                         routingEmailRouteExporter(),
 		"genesyscloud_routing_language":                            routingLanguageExporter(),
 		"genesyscloud_routing_queue":                               routingQueueExporter(),
+		"genesyscloud_routing_queue_conditional_group_routing":     routingQueueConditionalGroupRoutingExporter(),
 		"genesyscloud_routing_skill":                               routingSkillExporter(),
 		"genesyscloud_routing_utilization":                         routingUtilizationExporter(),
+		"genesyscloud_routing_utilization_label":                   routingUtilizationLabelExporter(),
 		"genesyscloud_routing_wrapupcode":                          routingWrapupCodeExporter(),
 		"genesyscloud_telephony_providers_edges_did_pool":          telephonyDidPoolExporter(),
 		"genesyscloud_telephony_providers_edges_edge_group":        edgeGroupExporter(),
@@ -214,8 +339,24 @@ func escapeRune(s string) string {
 var unsafeNameChars = regexp.MustCompile(`[^0-9A-Za-z_-]`)
 
 func sanitizeResourceNames(idMetaMap ResourceIDMetaMap) {
-	for _, meta := range idMetaMap {
+	nameIDs := make(map[string][]string, len(idMetaMap))
+	for id, meta := range idMetaMap {
+		meta.OriginalName = meta.Name
 		meta.Name = sanitizeResourceName(meta.Name)
+		nameIDs[meta.Name] = append(nameIDs[meta.Name], id)
+	}
+
+	// Objects with the same name are exported with a hash of their ID appended so resource names,
+	// references, and any exported files are unique and consistent between exports
+	for _, ids := range nameIDs {
+		if len(ids) < 2 {
+			continue
+		}
+		for _, id := range ids {
+			algorithm := fnv.New32()
+			algorithm.Write([]byte(id))
+			idMetaMap[id].Name = idMetaMap[id].Name + "_" + strconv.FormatUint(uint64(algorithm.Sum32()), 10)
+		}
 	}
 }
 
@@ -235,3 +376,70 @@ func sanitizeResourceName(inputName string) string {
 
 	return name
 }
+
+// exportManifestEntry describes an exported resource and the references resolved in its config
+type exportManifestEntry struct {
+	Type                 string                    `json:"type"`
+	ID                   string                    `json:"id"`
+	Name                 string                    `json:"name"`
+	Label                string                    `json:"label"`
+	Address              string                    `json:"address"`
+	DivisionID           string                    `json:"division_id,omitempty"`
+	ResolvedReferences   []exportManifestReference `json:"resolved_references"`
+	DroppedReferences    []exportManifestReference `json:"dropped_references"`
+	UnresolvedReferences []exportManifestReference `json:"unresolved_references,omitempty"`
+	Files                []string                  `json:"files,omitempty"`
+}
+
+// exportManifestReference describes a reference attribute value in an exported resource
+type exportManifestReference struct {
+	Attribute string `json:"attribute"`
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Address   string `json:"address,omitempty"`
+}
+
+func newExportManifestEntry(resource resourceInfo, exporter *ResourceExporter, configMap jsonMap) *exportManifestEntry {
+	entry := &exportManifestEntry{
+		Type:                 resource.Type,
+		ID:                   resource.ID,
+		Name:                 resource.Name,
+		Label:                resource.Name,
+		Address:              resource.Type + "." + resource.Name,
+		ResolvedReferences:   []exportManifestReference{},
+		DroppedReferences:    []exportManifestReference{},
+		UnresolvedReferences: []exportManifestReference{},
+		Files:                resource.Files,
+	}
+	if meta := exporter.SanitizedResourceMap[resource.ID]; meta != nil && meta.OriginalName != "" {
+		entry.Name = meta.OriginalName
+	}
+	if divisionID, ok := configMap["division_id"].(string); ok {
+		entry.DivisionID = divisionID
+	}
+	return entry
+}
+
+// addReference records the outcome of resolving a reference attribute value.
+// Resolved values are reference expressions, dropped values are empty, and
+// unresolved values are the original IDs kept when exporting state.
+func (m *exportManifestEntry) addReference(attribute string, refSettings *RefAttrSettings, refID string, resolvedValue string) {
+	if m == nil || refID == "" || stringInSlice(refID, refSettings.AltValues) {
+		return
+	}
+
+	ref := exportManifestReference{
+		Attribute: attribute,
+		Type:      refSettings.RefType,
+		ID:        refID,
+	}
+	switch resolvedValue {
+	case "":
+		m.DroppedReferences = append(m.DroppedReferences, ref)
+	case refID:
+		m.UnresolvedReferences = append(m.UnresolvedReferences, ref)
+	default:
+		ref.Address = strings.TrimSuffix(strings.TrimPrefix(resolvedValue, "${"), ".id}")
+		m.ResolvedReferences = append(m.ResolvedReferences, ref)
+	}
+}
@@ -86,9 +86,7 @@ func resourceArchitectDatatable() *schema.Resource {
 		ReadContext:   readWithPooledClient(readArchitectDatatable),
 		UpdateContext: updateWithPooledClient(updateArchitectDatatable),
 		DeleteContext: deleteWithPooledClient(deleteArchitectDatatable),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceArchitectDatatable, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -28,6 +28,25 @@ func splitDatatableRowId(rowId string) (string, string) {
 	return "", ""
 }
 
+// Rows can be imported by ID (<datatable ID>/<key>) or by datatable name (name:<datatable name>/<key>)
+func importArchitectDatatableRow(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	path, byName := getImportName(d.Id())
+	if !byName {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	tableName, keyVal := splitDatatableRowId(path)
+	if tableName == "" || keyVal == "" {
+		return nil, fmt.Errorf("Invalid datatable row import ID %s. Expected %s<datatable name>/<key>", d.Id(), importNamePrefix)
+	}
+	tableID, err := lookupIDByName(ctx, dataSourceArchitectDatatable, "name", tableName, meta)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(createDatatableRowId(tableID, keyVal))
+	return []*schema.ResourceData{d}, nil
+}
+
 func getAllArchitectDatatableRows(ctx context.Context, clientConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
 	resources := make(ResourceIDMetaMap)
 	archAPI := platformclientv2.NewArchitectApiWithConfig(clientConfig)
@@ -79,7 +98,7 @@ func resourceArchitectDatatableRow() *schema.Resource {
 		UpdateContext: updateWithPooledClient(updateArchitectDatatableRow),
 		DeleteContext: deleteWithPooledClient(deleteArchitectDatatableRow),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importArchitectDatatableRow,
 		},
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
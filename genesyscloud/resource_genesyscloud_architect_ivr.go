@@ -55,10 +55,16 @@ func resourceArchitectIvrConfig() *schema.Resource {
 		CreateContext: createWithPooledClient(createIvrConfig),
 		ReadContext:   readWithPooledClient(readIvrConfig),
 		UpdateContext: updateWithPooledClient(updateIvrConfig),
-		DeleteContext: deleteWithPooledClient(deleteIvrConfig),
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("IVR config", deleteIvrConfig)),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateReferencesDiff(map[string]referenceValidator{
+			"open_hours_flow_id":    flowReference("inboundcall"),
+			"closed_hours_flow_id":  flowReference("inboundcall"),
+			"holiday_hours_flow_id": flowReference("inboundcall"),
+			"schedule_group_id":     scheduleGroupReference,
+		}),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -99,6 +105,7 @@ func resourceArchitectIvrConfig() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
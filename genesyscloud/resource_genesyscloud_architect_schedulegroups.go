@@ -50,9 +50,7 @@ func resourceArchitectScheduleGroups() *schema.Resource {
 		ReadContext:   readWithPooledClient(readArchitectScheduleGroups),
 		UpdateContext: updateWithPooledClient(updateArchitectScheduleGroups),
 		DeleteContext: deleteWithPooledClient(deleteArchitectScheduleGroups),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceArchitectScheduleGroups, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
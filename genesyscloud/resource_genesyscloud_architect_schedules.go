@@ -51,9 +51,7 @@ func resourceArchitectSchedules() *schema.Resource {
 		ReadContext:   readWithPooledClient(readArchitectSchedules),
 		UpdateContext: updateWithPooledClient(updateArchitectSchedules),
 		DeleteContext: deleteWithPooledClient(deleteArchitectSchedules),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceSchedule, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -95,8 +93,7 @@ func createArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta
 	end := d.Get("end").(string)
 	rrule := d.Get("rrule").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	archAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+	archAPI := meta.(*providerMeta).architectProxy()
 
 	schedStart, err := time.Parse("2006-01-02T15:04:05.000000", start)
 	if err != nil {
@@ -133,8 +130,7 @@ func createArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func readArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	archAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+	archAPI := meta.(*providerMeta).architectProxy()
 
 	log.Printf("Reading schedule %s", d.Id())
 
@@ -187,8 +183,7 @@ func updateArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta
 	end := d.Get("end").(string)
 	rrule := d.Get("rrule").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	archAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+	archAPI := meta.(*providerMeta).architectProxy()
 
 	schedStart, err := time.Parse("2006-01-02T15:04:05.000000", start)
 	if err != nil {
@@ -231,8 +226,7 @@ func updateArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func deleteArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	archAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+	archAPI := meta.(*providerMeta).architectProxy()
 
 	log.Printf("Deleting schedule %s", d.Id())
 	_, err := archAPI.DeleteArchitectSchedule(d.Id())
@@ -9,7 +9,9 @@ import (
 	"log"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"time"
 
@@ -20,6 +22,9 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
 )
 
+// Directory within the export directory where user prompt audio files are written
+const userPromptExportDir = "prompts"
+
 var userPromptResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"language": {
@@ -713,7 +718,89 @@ func architectUserPromptExporter() *ResourceExporter {
 	return &ResourceExporter{
 		GetResourcesFunc: getAllWithPooledClient(getAllUserPrompts),
 		RefAttrs:         map[string]*RefAttrSettings{}, // No references
+		ExportFilesFunc:  exportFilesWithPooledClient(exportUserPromptFiles),
+	}
+}
+
+// Download the audio for each of a prompt's uploaded resources into the export directory
+// and update the exported filename attributes to reference the local files
+func exportUserPromptFiles(ctx context.Context, promptID string, resourceName string, configMap jsonMap, exportDir string, clientConfig *platformclientv2.Configuration) ([]string, diag.Diagnostics) {
+	architectAPI := platformclientv2.NewArchitectApiWithConfig(clientConfig)
+
+	userPrompt, _, err := architectAPI.GetArchitectPrompt(promptID)
+	if err != nil {
+		return nil, diag.Errorf("Failed to get user prompt %s: %v", promptID, err)
+	}
+	if userPrompt.Resources == nil {
+		return nil, nil
+	}
+
+	var files []string
+	configResources, _ := configMap["resources"].([]interface{})
+	for _, asset := range *userPrompt.Resources {
+		// Only resources with uploaded audio have media to export. TTS resources are generated from the config.
+		if asset.Language == nil || asset.MediaUri == nil || asset.UploadStatus == nil || *asset.UploadStatus != "transcoded" {
+			continue
+		}
+
+		filename := userPromptExportFilename(resourceName, *asset.Language, *asset.MediaUri)
+		log.Printf("Exporting audio for user prompt %s language %s to %s", *userPrompt.Name, *asset.Language, filename)
+		if err := downloadExportFile(ctx, *asset.MediaUri, filepath.Join(exportDir, userPromptExportDir), filename); err != nil {
+			return nil, diag.Errorf("Failed to export audio for user prompt %s language %s: %v", *userPrompt.Name, *asset.Language, err)
+		}
+
+		// Use forward slashes so the exported config is portable
+		exportedPath := path.Join(userPromptExportDir, filename)
+		files = append(files, exportedPath)
+		for _, configResource := range configResources {
+			if resourceMap, ok := configResource.(map[string]interface{}); ok && resourceMap["language"] == *asset.Language {
+				resourceMap["filename"] = exportedPath
+			}
+		}
+	}
+	return files, nil
+}
+
+// Audio files are named using the exported resource name so prompts with similar names do not overwrite each other
+func userPromptExportFilename(resourceName string, language string, mediaUri string) string {
+	extension := ".wav"
+	if parsedUri, err := url.Parse(mediaUri); err == nil && path.Ext(parsedUri.Path) != "" {
+		extension = path.Ext(parsedUri.Path)
+	}
+	return resourceName + "-" + language + extension
+}
+
+// Prompt audio is downloaded from a pre-signed URL outside of the SDK, so its requests need their own timeout
+var exportFileClient = &http.Client{Timeout: 5 * time.Minute}
+
+func downloadExportFile(ctx context.Context, fileUrl string, directory string, filename string) error {
+	if err := os.MkdirAll(directory, os.ModePerm); err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fileUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := exportFileClient.Do(request)
+	if err != nil {
+		return err
 	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status downloading %s: %s", fileUrl, response.Status)
+	}
+
+	file, err := os.Create(filepath.Join(directory, filename))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, response.Body)
+	return err
 }
 
 func resourceArchitectUserPrompt() *schema.Resource {
@@ -724,9 +811,7 @@ func resourceArchitectUserPrompt() *schema.Resource {
 		ReadContext:   readWithPooledClient(readUserPrompt),
 		UpdateContext: updateWithPooledClient(updateUserPrompt),
 		DeleteContext: deleteWithPooledClient(deleteUserPrompt),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceUserPrompt, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -49,10 +49,8 @@ func resourceAuthDivision() *schema.Resource {
 		CreateContext: createWithPooledClient(createAuthDivision),
 		ReadContext:   readWithPooledClient(readAuthDivision),
 		UpdateContext: updateWithPooledClient(updateAuthDivision),
-		DeleteContext: deleteWithPooledClient(deleteAuthDivision),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("Division", deleteAuthDivision)),
+		Importer:      importByName(dataSourceAuthDivision, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -71,6 +69,7 @@ func resourceAuthDivision() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -80,8 +79,7 @@ func createAuthDivision(ctx context.Context, d *schema.ResourceData, meta interf
 	description := d.Get("description").(string)
 	home := d.Get("home").(bool)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+	authAPI := meta.(*providerMeta).authorizationProxy()
 
 	if home {
 		// Home division must already exist, or it cannot be modified
@@ -94,11 +92,16 @@ func createAuthDivision(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	log.Printf("Creating division %s", name)
-	division, _, err := authAPI.PostAuthorizationDivisions(platformclientv2.Authzdivision{
+	division, resp, err := authAPI.PostAuthorizationDivisions(platformclientv2.Authzdivision{
 		Name:        &name,
 		Description: &description,
 	})
 	if err != nil {
+		if existingID, ok := getAdoptableObjectID(ctx, meta, resp, dataSourceAuthDivision, name); ok {
+			log.Printf("Adopting existing division %s %s", name, existingID)
+			d.SetId(existingID)
+			return updateAuthDivision(ctx, d, meta)
+		}
 		return diag.Errorf("Failed to create division %s: %s", name, err)
 	}
 
@@ -111,8 +114,7 @@ func createAuthDivision(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func readAuthDivision(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+	authAPI := meta.(*providerMeta).authorizationProxy()
 
 	log.Printf("Reading division %s", d.Id())
 
@@ -148,8 +150,7 @@ func updateAuthDivision(ctx context.Context, d *schema.ResourceData, meta interf
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+	authAPI := meta.(*providerMeta).authorizationProxy()
 
 	log.Printf("Updating division %s", name)
 	_, _, err := authAPI.PutAuthorizationDivision(d.Id(), platformclientv2.Authzdivision{
@@ -172,8 +173,7 @@ func deleteAuthDivision(ctx context.Context, d *schema.ResourceData, meta interf
 	name := d.Get("name").(string)
 	home := d.Get("home").(bool)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+	authAPI := meta.(*providerMeta).authorizationProxy()
 
 	if home {
 		// Do not delete home division
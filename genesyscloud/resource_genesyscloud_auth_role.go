@@ -153,9 +153,7 @@ func resourceAuthRole() *schema.Resource {
 		ReadContext:   readWithPooledClient(readAuthRole),
 		UpdateContext: updateWithPooledClient(updateAuthRole),
 		DeleteContext: deleteWithPooledClient(deleteAuthRole),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceAuthRole, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
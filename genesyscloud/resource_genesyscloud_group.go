@@ -81,9 +81,7 @@ func resourceGroup() *schema.Resource {
 		ReadContext:   readWithPooledClient(readGroup),
 		UpdateContext: updateWithPooledClient(updateGroup),
 		DeleteContext: deleteWithPooledClient(deleteGroup),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceGroup, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
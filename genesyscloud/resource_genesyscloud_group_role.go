@@ -0,0 +1,109 @@
+package genesyscloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+func resourceGroupRole() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Group Role maintains a single role assignment for a group. Unlike genesyscloud_group_roles, role grants not managed by this resource are left unchanged, so it should not be used for the same group as genesyscloud_group_roles.`,
+
+		CreateContext: createWithPooledClient(createGroupRole),
+		ReadContext:   readWithPooledClient(readGroupRole),
+		UpdateContext: updateWithPooledClient(updateGroupRole),
+		DeleteContext: deleteWithPooledClient(deleteGroupRole),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "Group ID that will be managed by this resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"role_id": {
+				Description: "Role ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"division_ids": {
+				Description: "Division IDs the role is granted in. If not set, the home division will be used. '*' may be set for all divisions.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func createGroupRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupID := d.Get("group_id").(string)
+	roleID := d.Get("role_id").(string)
+	d.SetId(createSubjectRoleID(groupID, roleID))
+	return updateGroupRole(ctx, d, meta)
+}
+
+func readGroupRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupID, roleID := splitSubjectRoleID(d.Id())
+	if groupID == "" {
+		return diag.Errorf("Invalid group role ID %s. Expected <group ID>/<role ID>", d.Id())
+	}
+
+	sdkConfig := meta.(*providerMeta).ClientConfig
+	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+
+	log.Printf("Reading role %s for group %s", roleID, groupID)
+
+	d.Set("group_id", groupID)
+	d.Set("role_id", roleID)
+
+	diagErr := readSubjectRole(ctx, d, groupID, roleID, authAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Read role %s for group %s", roleID, groupID)
+	return nil
+}
+
+func updateGroupRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupID, roleID := splitSubjectRoleID(d.Id())
+
+	sdkConfig := meta.(*providerMeta).ClientConfig
+	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+
+	log.Printf("Updating role %s for group %s", roleID, groupID)
+	diagErr := updateSubjectRole(d, groupID, roleID, authAPI, "PC_GROUP")
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated role %s for group %s", roleID, groupID)
+	return readGroupRole(ctx, d, meta)
+}
+
+func deleteGroupRole(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupID, roleID := splitSubjectRoleID(d.Id())
+
+	sdkConfig := meta.(*providerMeta).ClientConfig
+	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+
+	// Does not delete groups or roles. Only the grants managed by this resource are removed.
+	log.Printf("Removing role %s for group %s", roleID, groupID)
+	diagErr := deleteSubjectRole(d, groupID, roleID, authAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Removed role %s for group %s", roleID, groupID)
+	return nil
+}
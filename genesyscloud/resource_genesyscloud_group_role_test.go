@@ -0,0 +1,100 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceGroupRole(t *testing.T) {
+	var (
+		groupRoleResource1 = "test-group-role-1"
+		groupRoleResource2 = "test-group-role-2"
+		groupResource1     = "test-group"
+		groupName          = "terraform-" + uuid.NewString()
+		roleResource1      = "test-role-1"
+		roleResource2      = "test-role-2"
+		roleName1          = "Terraform Group Role Test1" + uuid.NewString()
+		roleName2          = "Terraform Group Role Test2" + uuid.NewString()
+		roleDesc           = "Terraform group role test"
+		divResource        = "test-division"
+		divName            = "terraform-" + uuid.NewString()
+	)
+
+	config := generateBasicGroupResource(
+		groupResource1,
+		groupName,
+	) + generateAuthRoleResource(
+		roleResource1,
+		roleName1,
+		roleDesc,
+	) + generateAuthRoleResource(
+		roleResource2,
+		roleName2,
+		roleDesc,
+	) + generateAuthDivisionBasic(divResource, divName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Grant two roles with separate resources
+				Config: config + generateGroupRole(
+					groupRoleResource1,
+					"genesyscloud_group."+groupResource1+".id",
+					"genesyscloud_auth_role."+roleResource1+".id",
+				) + generateGroupRole(
+					groupRoleResource2,
+					"genesyscloud_group."+groupResource1+".id",
+					"genesyscloud_auth_role."+roleResource2+".id",
+					"genesyscloud_auth_division."+divResource+".id",
+					strconv.Quote("*"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_group_role."+groupRoleResource1, "division_ids.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_group_role."+groupRoleResource2, "division_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("genesyscloud_group_role."+groupRoleResource2, "division_ids.*", "genesyscloud_auth_division."+divResource, "id"),
+					resource.TestCheckTypeSetElemAttr("genesyscloud_group_role."+groupRoleResource2, "division_ids.*", "*"),
+				),
+			},
+			{
+				// Remove one of the roles. The other role is not removed from the group.
+				Config: config + generateGroupRole(
+					groupRoleResource2,
+					"genesyscloud_group."+groupResource1+".id",
+					"genesyscloud_auth_role."+roleResource2+".id",
+					"genesyscloud_auth_division."+divResource+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_group_role."+groupRoleResource2, "division_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("genesyscloud_group_role."+groupRoleResource2, "division_ids.*", "genesyscloud_auth_division."+divResource, "id"),
+					testVerifySubjectRoleGranted("genesyscloud_group."+groupResource1, "genesyscloud_auth_role."+roleResource2),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_group_role." + groupRoleResource2,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func generateGroupRole(resourceID string, groupID string, roleID string, divisionIds ...string) string {
+	var divAttr string
+	if len(divisionIds) > 0 {
+		divAttr = "division_ids = [" + strings.Join(divisionIds, ",") + "]"
+	}
+	return fmt.Sprintf(`resource "genesyscloud_group_role" "%s" {
+		group_id = %s
+		role_id = %s
+		%s
+	}
+	`, resourceID, groupID, roleID, divAttr)
+}
@@ -43,7 +43,7 @@ func resourceIdpAdfs() *schema.Resource {
 		CreateContext: createWithPooledClient(createIdpAdfs),
 		ReadContext:   readWithPooledClient(readIdpAdfs),
 		UpdateContext: updateWithPooledClient(updateIdpAdfs),
-		DeleteContext: deleteWithPooledClient(deleteIdpAdfs),
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("IdP", deleteIdpAdfs)),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -76,6 +76,7 @@ func resourceIdpAdfs() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -44,7 +44,7 @@ func resourceIdpGeneric() *schema.Resource {
 		CreateContext: createWithPooledClient(createIdpGeneric),
 		ReadContext:   readWithPooledClient(readIdpGeneric),
 		UpdateContext: updateWithPooledClient(updateIdpGeneric),
-		DeleteContext: deleteWithPooledClient(deleteIdpGeneric),
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("IdP", deleteIdpGeneric)),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -109,6 +109,7 @@ func resourceIdpGeneric() *schema.Resource {
 					"urn:oasis:names:tc:SAML:2.0:nameid-format:transient",
 				}, false),
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -43,7 +43,7 @@ func resourceIdpGsuite() *schema.Resource {
 		CreateContext: createWithPooledClient(createIdpGsuite),
 		ReadContext:   readWithPooledClient(readIdpGsuite),
 		UpdateContext: updateWithPooledClient(updateIdpGsuite),
-		DeleteContext: deleteWithPooledClient(deleteIdpGsuite),
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("IdP", deleteIdpGsuite)),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -76,6 +76,7 @@ func resourceIdpGsuite() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
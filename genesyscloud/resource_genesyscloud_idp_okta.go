@@ -43,7 +43,7 @@ func resourceIdpOkta() *schema.Resource {
 		CreateContext: createWithPooledClient(createIdpOkta),
 		ReadContext:   readWithPooledClient(readIdpOkta),
 		UpdateContext: updateWithPooledClient(updateIdpOkta),
-		DeleteContext: deleteWithPooledClient(deleteIdpOkta),
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("IdP", deleteIdpOkta)),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -71,6 +71,7 @@ func resourceIdpOkta() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
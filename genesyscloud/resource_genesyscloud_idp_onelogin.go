@@ -43,7 +43,7 @@ func resourceIdpOnelogin() *schema.Resource {
 		CreateContext: createWithPooledClient(createIdpOnelogin),
 		ReadContext:   readWithPooledClient(readIdpOnelogin),
 		UpdateContext: updateWithPooledClient(updateIdpOnelogin),
-		DeleteContext: deleteWithPooledClient(deleteIdpOnelogin),
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("IdP", deleteIdpOnelogin)),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -71,6 +71,7 @@ func resourceIdpOnelogin() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
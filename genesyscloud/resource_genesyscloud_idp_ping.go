@@ -43,7 +43,7 @@ func resourceIdpPing() *schema.Resource {
 		CreateContext: createWithPooledClient(createIdpPing),
 		ReadContext:   readWithPooledClient(readIdpPing),
 		UpdateContext: updateWithPooledClient(updateIdpPing),
-		DeleteContext: deleteWithPooledClient(deleteIdpPing),
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("IdP", deleteIdpPing)),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -76,6 +76,7 @@ func resourceIdpPing() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -43,7 +43,7 @@ func resourceIdpSalesforce() *schema.Resource {
 		CreateContext: createWithPooledClient(createIdpSalesforce),
 		ReadContext:   readWithPooledClient(readIdpSalesforce),
 		UpdateContext: updateWithPooledClient(updateIdpSalesforce),
-		DeleteContext: deleteWithPooledClient(deleteIdpSalesforce),
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("IdP", deleteIdpSalesforce)),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -71,6 +71,7 @@ func resourceIdpSalesforce() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -92,9 +92,7 @@ func resourceIntegration() *schema.Resource {
 		ReadContext:   readWithPooledClient(readIntegration),
 		UpdateContext: updateWithPooledClient(updateIntegration),
 		DeleteContext: deleteWithPooledClient(deleteIntegration),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceIntegration, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"intended_state": {
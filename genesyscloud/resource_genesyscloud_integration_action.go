@@ -114,9 +114,7 @@ func resourceIntegrationAction() *schema.Resource {
 		ReadContext:   readWithPooledClient(readIntegrationAction),
 		UpdateContext: updateWithPooledClient(updateIntegrationAction),
 		DeleteContext: deleteWithPooledClient(deleteIntegrationAction),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceIntegrationAction, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -52,9 +52,7 @@ func resourceCredential() *schema.Resource {
 		ReadContext:   readWithPooledClient(readCredential),
 		UpdateContext: updateWithPooledClient(updateCredential),
 		DeleteContext: deleteWithPooledClient(deleteCredential),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceIntegrationCredential, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
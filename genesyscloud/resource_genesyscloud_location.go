@@ -54,9 +54,7 @@ func resourceLocation() *schema.Resource {
 		ReadContext:   readWithPooledClient(readLocation),
 		UpdateContext: updateWithPooledClient(updateLocation),
 		DeleteContext: deleteWithPooledClient(deleteLocation),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceLocation, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
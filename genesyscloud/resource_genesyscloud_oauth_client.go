@@ -75,10 +75,8 @@ func resourceOAuthClient() *schema.Resource {
 		CreateContext: createWithPooledClient(createOAuthClient),
 		ReadContext:   readWithPooledClient(readOAuthClient),
 		UpdateContext: updateWithPooledClient(updateOAuthClient),
-		DeleteContext: deleteWithPooledClient(deleteOAuthClient),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("OAuth client", deleteOAuthClient)),
+		Importer:      importByName(dataSourceOAuthClient, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -129,6 +127,7 @@ func resourceOAuthClient() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
 				Default:      "active",
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -178,7 +178,6 @@ func evaluationFormExporter() *ResourceExporter {
 	return &ResourceExporter{
 		GetResourcesFunc: getAllWithPooledClient(getAllEvaluationForms),
 		RefAttrs:         map[string]*RefAttrSettings{}, // No references
-		AllowZeroValues: []string{"question_groups.questions.answer_options.value"},
 	}
 }
 
@@ -189,9 +188,7 @@ func resourceEvaluationForm() *schema.Resource {
 		ReadContext:   readWithPooledClient(readEvaluationForm),
 		UpdateContext: updateWithPooledClient(updateEvaluationForm),
 		DeleteContext: deleteWithPooledClient(deleteEvaluationForm),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceQualityFormsEvaluations, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -50,9 +50,7 @@ func resourceRoutingEmailDomain() *schema.Resource {
 		ReadContext:   readWithPooledClient(readRoutingEmailDomain),
 		UpdateContext: updateWithPooledClient(updateRoutingEmailDomain),
 		DeleteContext: deleteWithPooledClient(deleteRoutingEmailDomain),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceRoutingEmailDomain, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"domain_id": {
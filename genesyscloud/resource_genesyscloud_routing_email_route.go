@@ -185,17 +185,62 @@ func resourceRoutingEmailRoute() *schema.Resource {
 	}
 }
 
-func importRoutingEmailRoute(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	// Import must specify domain ID and route ID
-	idParts := strings.Split(d.Id(), "/")
-	if len(idParts) < 2 {
+func importRoutingEmailRoute(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Import must specify domain ID and route ID, or domain ID and route pattern with the name prefix
+	path, byName := getImportName(d.Id())
+	if !byName {
+		path = d.Id()
+	}
+	idParts := strings.SplitN(path, "/", 2)
+	if len(idParts) < 2 || idParts[0] == "" || idParts[1] == "" {
 		return nil, fmt.Errorf("Invalid email route import ID %s", d.Id())
 	}
-	d.Set("domain_id", idParts[0])
-	d.SetId(idParts[1])
+	domainID := idParts[0]
+	routeID := idParts[1]
+
+	if byName {
+		var err error
+		if routeID, err = getRoutingEmailRouteIdByPattern(domainID, idParts[1], meta); err != nil {
+			return nil, err
+		}
+	}
+	d.Set("domain_id", domainID)
+	d.SetId(routeID)
 	return []*schema.ResourceData{d}, nil
 }
 
+func getRoutingEmailRouteIdByPattern(domainID string, pattern string, meta interface{}) (string, error) {
+	sdkConfig := meta.(*providerMeta).ClientConfig
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+	var routeIDs []string
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		routes, _, getErr := routingAPI.GetRoutingEmailDomainRoutes(domainID, pageSize, pageNum, pattern)
+		if getErr != nil {
+			return "", fmt.Errorf("Failed to get page of email routes for domain %s: %v", domainID, getErr)
+		}
+
+		if routes.Entities == nil || len(*routes.Entities) == 0 {
+			break
+		}
+
+		for _, route := range *routes.Entities {
+			if route.Pattern != nil && *route.Pattern == pattern {
+				routeIDs = append(routeIDs, *route.Id)
+			}
+		}
+	}
+
+	if len(routeIDs) == 0 {
+		return "", fmt.Errorf("No email route found in domain %s with pattern %s", domainID, pattern)
+	}
+	if len(routeIDs) > 1 {
+		return "", ambiguousImportError(pattern, len(routeIDs))
+	}
+	return routeIDs[0], nil
+}
+
 func createRoutingEmailRoute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	domainID := d.Get("domain_id").(string)
 	pattern := d.Get("pattern").(string)
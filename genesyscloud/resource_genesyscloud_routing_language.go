@@ -51,9 +51,7 @@ func resourceRoutingLanguage() *schema.Resource {
 		CreateContext: createWithPooledClient(createRoutingLanguage),
 		ReadContext:   readWithPooledClient(readRoutingLanguage),
 		DeleteContext: deleteWithPooledClient(deleteRoutingLanguage),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceRoutingLanguage, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -73,10 +71,15 @@ func createRoutingLanguage(ctx context.Context, d *schema.ResourceData, meta int
 	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
 
 	log.Printf("Creating language %s", name)
-	language, _, err := routingAPI.PostRoutingLanguages(platformclientv2.Language{
+	language, resp, err := routingAPI.PostRoutingLanguages(platformclientv2.Language{
 		Name: &name,
 	})
 	if err != nil {
+		if existingID, ok := getAdoptableObjectID(ctx, meta, resp, dataSourceRoutingLanguage, name); ok {
+			log.Printf("Adopting existing language %s %s", name, existingID)
+			d.SetId(existingID)
+			return readRoutingLanguage(ctx, d, meta)
+		}
 		return diag.Errorf("Failed to create language %s: %s", name, err)
 	}
 
@@ -2,12 +2,8 @@ package genesyscloud
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
-	"net/http"
-	"net/url"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -49,6 +45,64 @@ var (
 				Required:     true,
 				ValidateFunc: validation.IntAtLeast(1000),
 			},
+			"enable_auto_answer": {
+				Description: "Indicates whether auto answer is enabled for this media type.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"auto_answer_alert_tone_seconds": {
+				Description:  "How long to play the alerting tone for an auto-answer interaction.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"manual_answer_alert_tone_seconds": {
+				Description:  "How long to play the alerting tone for a manual-answer interaction.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+		},
+	}
+
+	queueCallbackMediaSettingsResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"alerting_timeout_sec":             queueMediaSettingsResource.Schema["alerting_timeout_sec"],
+			"service_level_percentage":         queueMediaSettingsResource.Schema["service_level_percentage"],
+			"service_level_duration_ms":        queueMediaSettingsResource.Schema["service_level_duration_ms"],
+			"enable_auto_answer":               queueMediaSettingsResource.Schema["enable_auto_answer"],
+			"auto_answer_alert_tone_seconds":   queueMediaSettingsResource.Schema["auto_answer_alert_tone_seconds"],
+			"manual_answer_alert_tone_seconds": queueMediaSettingsResource.Schema["manual_answer_alert_tone_seconds"],
+			"mode": {
+				Description:  "The mode callbacks will use on this queue (AgentFirst | CustomerFirst).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AgentFirst", "CustomerFirst"}, false),
+			},
+			"enable_auto_dial_and_end": {
+				Description: "Indicates whether to automatically dial the customer and end the callback after the agent wraps up.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"auto_dial_delay_seconds": {
+				Description:  "Seconds to wait before automatically dialing the customer.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"auto_end_delay_seconds": {
+				Description:  "Seconds to wait before automatically ending the callback after the agent wraps up.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 	}
 
@@ -68,6 +122,29 @@ var (
 			},
 		},
 	}
+
+	queueMemberGroupResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "Group or team ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"type": {
+				Description:  "Type of the member group (GROUP | TEAM).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      memberGroupTypeGroup,
+				ValidateFunc: validation.StringInSlice([]string{memberGroupTypeGroup, memberGroupTypeTeam}, false),
+			},
+		},
+	}
+)
+
+const (
+	memberGroupTypeGroup      = "GROUP"
+	memberGroupTypeTeam       = "TEAM"
+	memberGroupTypeSkillGroup = "SKILLGROUP"
 )
 
 func getAllRoutingQueues(_ context.Context, clientConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
@@ -102,18 +179,29 @@ func routingQueueExporter() *ResourceExporter {
 		RefAttrs: map[string]*RefAttrSettings{
 			"division_id":                       {RefType: "genesyscloud_auth_division"},
 			"queue_flow_id":                     {}, // Ref type not yet defined
+			"email_in_queue_flow_id":            {}, // Ref type not yet defined
+			"message_in_queue_flow_id":          {}, // Ref type not yet defined
 			"whisper_prompt_id":                 {}, // Ref type not yet defined
-			"outbound_messaging_sms_address_id": {}, // Ref type not yet defined
+			"outbound_messaging_sms_address_id": {}, // SMS phone numbers are not managed by this provider
 			"default_script_ids.*":              {}, // Ref type not yet defined
 			"outbound_email_address.route_id":   {RefType: "genesyscloud_routing_email_route"},
 			"outbound_email_address.domain_id":  {RefType: "genesyscloud_routing_email_domain"},
 			"bullseye_rings.skills_to_remove":   {RefType: "genesyscloud_routing_skill"},
+			"direct_routing.backup_queue_id":    {RefType: "genesyscloud_routing_queue"},
 			"members.user_id":                   {RefType: "genesyscloud_user"},
+			"skill_groups":                      {}, // Ref type not yet defined
 			"wrapup_codes":                      {RefType: "genesyscloud_routing_wrapupcode"},
+			// Team IDs are kept as-is. Ref type not yet defined
+			"member_groups.group_id": {
+				RefType:         "genesyscloud_group",
+				ConditionAttr:   "type",
+				ConditionValues: []string{memberGroupTypeGroup},
+			},
 		},
 		RemoveIfMissing: map[string][]string{
 			"outbound_email_address": {"route_id"},
 			"members":                {"user_id"},
+			"member_groups":          {"group_id"},
 		},
 	}
 }
@@ -125,10 +213,18 @@ func resourceRoutingQueue() *schema.Resource {
 		CreateContext: createWithPooledClient(createQueue),
 		ReadContext:   readWithPooledClient(readQueue),
 		UpdateContext: updateWithPooledClient(updateQueue),
-		DeleteContext: deleteWithPooledClient(deleteQueue),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("Queue", deleteQueue)),
+		Importer:      importByName(dataSourceRoutingQueue, "name"),
+		CustomizeDiff: validateReferencesDiff(map[string]referenceValidator{
+			"division_id":                     divisionReference,
+			"queue_flow_id":                   flowReference("inqueuecall"),
+			"email_in_queue_flow_id":          flowReference("inqueueemail"),
+			"message_in_queue_flow_id":        flowReference("inqueueshortmessage"),
+			"whisper_prompt_id":               promptReference,
+			"bullseye_rings.skills_to_remove": skillReference,
+			"members.user_id":                 userReference,
+			"wrapup_codes":                    wrapupcodeReference,
+		}),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -161,7 +257,7 @@ func resourceRoutingQueue() *schema.Resource {
 				MaxItems:    1,
 				Optional:    true,
 				Computed:    true,
-				Elem:        queueMediaSettingsResource,
+				Elem:        queueCallbackMediaSettingsResource,
 			},
 			"media_settings_chat": {
 				Description: "Chat media settings.",
@@ -280,6 +376,16 @@ func resourceRoutingQueue() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"email_in_queue_flow_id": {
+				Description: "The in-queue flow ID to use for email conversations waiting in queue.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"message_in_queue_flow_id": {
+				Description: "The in-queue flow ID to use for message conversations waiting in queue.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"whisper_prompt_id": {
 				Description: "The prompt ID used for whisper on the queue, if configured.",
 				Type:        schema.TypeString,
@@ -321,7 +427,7 @@ func resourceRoutingQueue() *schema.Resource {
 				Elem:             &schema.Schema{Type: schema.TypeString},
 			},
 			"outbound_messaging_sms_address_id": {
-				Description: "The unique ID of the outbound messaging SMS address for the queue.",
+				Description: "The unique ID of the SMS phone number used for outbound messages from the queue. SMS is the only outbound messaging address the queue API supports. SMS phone numbers are not managed by this provider, so exports keep this ID as-is.",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
@@ -345,14 +451,113 @@ func resourceRoutingQueue() *schema.Resource {
 					},
 				},
 			},
+			"agent_owned_routing": {
+				Description: "Agent owned routing settings for the queue. If not set, this resource will not manage agent owned routing.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_agent_owned_callbacks": {
+							Description: "Enables agents to schedule callbacks that are routed to themselves.",
+							Type:        schema.TypeBool,
+							Required:    true,
+						},
+						"max_owned_callback_hours": {
+							Description:  "Hours an agent owned callback is routed to the agent before it is routed to the queue.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_owned_callback_delay_hours": {
+							Description:  "Maximum number of hours an agent owned callback can be scheduled in the future.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"direct_routing": {
+				Description: "Direct routing settings, used to route conversations to a specific agent through this queue. If not set, this resource will not manage direct routing.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_queue_id": {
+							Description: "ID of the queue to route to if the agent is not available.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"wait_for_agent": {
+							Description: "Indicates whether to wait for the agent before routing to the backup queue.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"agent_wait_seconds": {
+							Description:  "Seconds to wait for the agent when wait_for_agent is true.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"call_use_agent_address_outbound": {
+							Description: "Indicates whether the agent's direct routing address is used for outbound calls.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+						"email_use_agent_address_outbound": {
+							Description: "Indicates whether the agent's direct routing address is used for outbound emails.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+						"message_use_agent_address_outbound": {
+							Description: "Indicates whether the agent's direct routing address is used for outbound messages.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
 			"members": {
-				Description: "Users in the queue. If not set, this resource will not manage members.",
+				Description: "Users added directly to the queue. Users who are members through `member_groups` or `skill_groups` are not included. If not set, this resource will not manage members.",
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Computed:    true,
 				ConfigMode:  schema.SchemaConfigModeAttr,
 				Elem:        queueMemberResource,
 			},
+			"ignore_members": {
+				Description:   "If true, this resource will not read or manage members. Set this when members are managed with `genesyscloud_routing_queue_member` resources to avoid reading the entire membership of large queues. Cannot be set with `members`.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"members"},
+			},
+			"member_groups": {
+				Description: "Groups and teams whose users are members of the queue. Membership follows changes to the groups. Ring numbers can only be set for `members`. The queue API does not support them for member groups. If not set, this resource will not manage member groups.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Elem:        queueMemberGroupResource,
+			},
+			"skill_groups": {
+				Description: "IDs of skill groups whose users are members of the queue. Ring numbers are not supported for skill groups. If not set, this resource will not manage skill groups.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"wrapup_codes": {
 				Description: "IDs of wrapup codes assigned to this queue. If not set, this resource will not manage wrapup codes.",
 				Type:        schema.TypeSet,
@@ -360,6 +565,7 @@ func resourceRoutingQueue() *schema.Resource {
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -375,8 +581,7 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	callingPartyName := d.Get("calling_party_name").(string)
 	callingPartyNumber := d.Get("calling_party_number").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	createQueue := platformclientv2.Createqueuerequest{
 		Name:                       &name,
@@ -387,6 +592,8 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 		AcwSettings:                buildSdkAcwSettings(d),
 		SkillEvaluationMethod:      &skillEvaluationMethod,
 		QueueFlow:                  buildSdkDomainEntityRef(d, "queue_flow_id"),
+		EmailInQueueFlow:           buildSdkDomainEntityRef(d, "email_in_queue_flow_id"),
+		MessageInQueueFlow:         buildSdkDomainEntityRef(d, "message_in_queue_flow_id"),
 		WhisperPrompt:              buildSdkDomainEntityRef(d, "whisper_prompt_id"),
 		AutoAnswerOnly:             &autoAnswerOnly,
 		CallingPartyName:           &callingPartyName,
@@ -403,12 +610,26 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("Creating queue %s", name)
-	queue, _, err := routingAPI.PostRoutingQueues(createQueue)
+	queue, resp, err := routingAPI.PostRoutingQueues(createQueue)
 	if err != nil {
+		if existingID, ok := getAdoptableObjectID(ctx, meta, resp, dataSourceRoutingQueue, name); ok {
+			log.Printf("Adopting existing queue %s %s", name, existingID)
+			d.SetId(existingID)
+			return updateQueue(ctx, d, meta)
+		}
 		return diag.Errorf("Failed to create queue %s: %s", name, err)
 	}
 	d.SetId(*queue.Id)
 
+	extensions := buildQueueExtensions(d)
+	if len(*extensions.MemberGroups) > 0 || len(*extensions.MediaSettings) > 0 || extensions.AgentOwnedRouting != nil || extensions.DirectRouting != nil {
+		// Settings not supported by the SDK can only be set by updating the queue
+		_, err = routingAPI.PutRoutingQueueWithExtensions(d.Id(), buildSdkQueueRequest(d), extensions)
+		if err != nil {
+			return diag.Errorf("Failed to update settings for queue %s: %s", name, err)
+		}
+	}
+
 	diagErr := updateQueueMembers(d, routingAPI)
 	if diagErr != nil {
 		return diagErr
@@ -423,18 +644,20 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 }
 
 func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Reading queue %s", d.Id())
 	return withRetriesForRead(ctx, 30*time.Second, d, func() *resource.RetryError {
-		currentQueue, resp, getErr := routingAPI.GetRoutingQueue(d.Id())
+		currentQueue, extensions, resp, getErr := routingAPI.GetRoutingQueueWithExtensions(d.Id())
 		if getErr != nil {
 			if isStatus404(resp) {
 				return resource.RetryableError(fmt.Errorf("Failed to read queue %s: %s", d.Id(), getErr))
 			}
 			return resource.NonRetryableError(fmt.Errorf("Failed to read queue %s: %s", d.Id(), getErr))
 		}
+		if extensions == nil {
+			extensions = &queueExtensions{}
+		}
 
 		d.Set("name", *currentQueue.Name)
 		d.Set("division_id", *currentQueue.Division.Id)
@@ -471,25 +694,25 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		d.Set("media_settings_video", nil)
 		if currentQueue.MediaSettings != nil {
 			if callSettings, ok := (*currentQueue.MediaSettings)[mediaSettingsKeyCall]; ok {
-				d.Set("media_settings_call", flattenMediaSetting(callSettings))
+				d.Set("media_settings_call", flattenMediaSetting(callSettings, getQueueMediaSettingExtensions(extensions, mediaSettingsKeyCall)))
 			}
 			if callbackSettings, ok := (*currentQueue.MediaSettings)[mediaSettingsKeyCallback]; ok {
-				d.Set("media_settings_callback", flattenMediaSetting(callbackSettings))
+				d.Set("media_settings_callback", flattenMediaSetting(callbackSettings, getQueueMediaSettingExtensions(extensions, mediaSettingsKeyCallback)))
 			}
 			if chatSettings, ok := (*currentQueue.MediaSettings)[mediaSettingsKeyChat]; ok {
-				d.Set("media_settings_chat", flattenMediaSetting(chatSettings))
+				d.Set("media_settings_chat", flattenMediaSetting(chatSettings, getQueueMediaSettingExtensions(extensions, mediaSettingsKeyChat)))
 			}
 			if emailSettings, ok := (*currentQueue.MediaSettings)[mediaSettingsKeyEmail]; ok {
-				d.Set("media_settings_email", flattenMediaSetting(emailSettings))
+				d.Set("media_settings_email", flattenMediaSetting(emailSettings, getQueueMediaSettingExtensions(extensions, mediaSettingsKeyEmail)))
 			}
 			if messageSettings, ok := (*currentQueue.MediaSettings)[mediaSettingsKeyMessage]; ok {
-				d.Set("media_settings_message", flattenMediaSetting(messageSettings))
+				d.Set("media_settings_message", flattenMediaSetting(messageSettings, getQueueMediaSettingExtensions(extensions, mediaSettingsKeyMessage)))
 			}
 			if socialSettings, ok := (*currentQueue.MediaSettings)[mediaSettingsKeySocial]; ok {
-				d.Set("media_settings_social", flattenMediaSetting(socialSettings))
+				d.Set("media_settings_social", flattenMediaSetting(socialSettings, getQueueMediaSettingExtensions(extensions, mediaSettingsKeySocial)))
 			}
 			if videoSettings, ok := (*currentQueue.MediaSettings)[mediaSettingsKeyVideo]; ok {
-				d.Set("media_settings_video", flattenMediaSetting(videoSettings))
+				d.Set("media_settings_video", flattenMediaSetting(videoSettings, getQueueMediaSettingExtensions(extensions, mediaSettingsKeyVideo)))
 			}
 		}
 
@@ -511,6 +734,18 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 			d.Set("queue_flow_id", nil)
 		}
 
+		if currentQueue.EmailInQueueFlow != nil && currentQueue.EmailInQueueFlow.Id != nil {
+			d.Set("email_in_queue_flow_id", *currentQueue.EmailInQueueFlow.Id)
+		} else {
+			d.Set("email_in_queue_flow_id", nil)
+		}
+
+		if currentQueue.MessageInQueueFlow != nil && currentQueue.MessageInQueueFlow.Id != nil {
+			d.Set("message_in_queue_flow_id", *currentQueue.MessageInQueueFlow.Id)
+		} else {
+			d.Set("message_in_queue_flow_id", nil)
+		}
+
 		if currentQueue.WhisperPrompt != nil && currentQueue.WhisperPrompt.Id != nil {
 			d.Set("whisper_prompt_id", *currentQueue.WhisperPrompt.Id)
 		} else {
@@ -565,11 +800,31 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 			d.Set("outbound_email_address", nil)
 		}
 
-		members, err := flattenQueueMembers(d.Id(), routingAPI)
-		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("%v", err))
+		groups, skillGroups := flattenQueueMemberGroups(extensions.MemberGroups)
+		d.Set("member_groups", groups)
+		d.Set("skill_groups", skillGroups)
+
+		if extensions.AgentOwnedRouting != nil {
+			d.Set("agent_owned_routing", flattenQueueAgentOwnedRouting(*extensions.AgentOwnedRouting))
+		} else {
+			d.Set("agent_owned_routing", nil)
+		}
+
+		if extensions.DirectRouting != nil {
+			d.Set("direct_routing", flattenQueueDirectRouting(*extensions.DirectRouting))
+		} else {
+			d.Set("direct_routing", nil)
+		}
+
+		if d.Get("ignore_members").(bool) {
+			d.Set("members", nil)
+		} else {
+			members, err := flattenQueueMembers(d.Id(), routingAPI)
+			if err != nil {
+				return resource.NonRetryableError(fmt.Errorf("%v", err))
+			}
+			d.Set("members", members)
 		}
-		d.Set("members", members)
 
 		wrapupCodes, err := flattenQueueWrapupCodes(d.Id(), routingAPI)
 		if err != nil {
@@ -584,38 +839,13 @@ func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 
 func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
-	description := d.Get("description").(string)
-	skillEvaluationMethod := d.Get("skill_evaluation_method").(string)
-	autoAnswerOnly := d.Get("auto_answer_only").(bool)
-	enableTranscription := d.Get("enable_transcription").(bool)
-	enableManualAssignment := d.Get("enable_manual_assignment").(bool)
-	callingPartyName := d.Get("calling_party_name").(string)
-	callingPartyNumber := d.Get("calling_party_number").(string)
 
 	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Updating queue %s", name)
 
-	_, _, err := routingAPI.PutRoutingQueue(d.Id(), platformclientv2.Queuerequest{
-		Name:                       &name,
-		Description:                &description,
-		MediaSettings:              buildSdkMediaSettings(d),
-		RoutingRules:               buildSdkRoutingRules(d),
-		Bullseye:                   buildSdkBullseyeSettings(d),
-		AcwSettings:                buildSdkAcwSettings(d),
-		SkillEvaluationMethod:      &skillEvaluationMethod,
-		QueueFlow:                  buildSdkDomainEntityRef(d, "queue_flow_id"),
-		WhisperPrompt:              buildSdkDomainEntityRef(d, "whisper_prompt_id"),
-		AutoAnswerOnly:             &autoAnswerOnly,
-		CallingPartyName:           &callingPartyName,
-		CallingPartyNumber:         &callingPartyNumber,
-		DefaultScripts:             buildSdkDefaultScriptsMap(d),
-		OutboundMessagingAddresses: buildSdkQueueMessagingAddresses(d),
-		OutboundEmailAddress:       buildSdkQueueEmailAddress(d),
-		EnableTranscription:        &enableTranscription,
-		EnableManualAssignment:     &enableManualAssignment,
-	})
+	_, err := routingAPI.PutRoutingQueueWithExtensions(d.Id(), buildSdkQueueRequest(d), buildQueueExtensions(d))
 	if err != nil {
 		return diag.Errorf("Error updating queue %s: %s", name, err)
 	}
@@ -643,8 +873,7 @@ func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 func deleteQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Deleting queue %s", name)
 	_, err := routingAPI.DeleteRoutingQueue(d.Id(), true)
@@ -671,6 +900,39 @@ func deleteQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	})
 }
 
+func buildSdkQueueRequest(d *schema.ResourceData) platformclientv2.Queuerequest {
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+	skillEvaluationMethod := d.Get("skill_evaluation_method").(string)
+	autoAnswerOnly := d.Get("auto_answer_only").(bool)
+	enableTranscription := d.Get("enable_transcription").(bool)
+	enableManualAssignment := d.Get("enable_manual_assignment").(bool)
+	callingPartyName := d.Get("calling_party_name").(string)
+	callingPartyNumber := d.Get("calling_party_number").(string)
+
+	return platformclientv2.Queuerequest{
+		Name:                       &name,
+		Description:                &description,
+		MediaSettings:              buildSdkMediaSettings(d),
+		RoutingRules:               buildSdkRoutingRules(d),
+		Bullseye:                   buildSdkBullseyeSettings(d),
+		AcwSettings:                buildSdkAcwSettings(d),
+		SkillEvaluationMethod:      &skillEvaluationMethod,
+		QueueFlow:                  buildSdkDomainEntityRef(d, "queue_flow_id"),
+		EmailInQueueFlow:           buildSdkDomainEntityRef(d, "email_in_queue_flow_id"),
+		MessageInQueueFlow:         buildSdkDomainEntityRef(d, "message_in_queue_flow_id"),
+		WhisperPrompt:              buildSdkDomainEntityRef(d, "whisper_prompt_id"),
+		AutoAnswerOnly:             &autoAnswerOnly,
+		CallingPartyName:           &callingPartyName,
+		CallingPartyNumber:         &callingPartyNumber,
+		DefaultScripts:             buildSdkDefaultScriptsMap(d),
+		OutboundMessagingAddresses: buildSdkQueueMessagingAddresses(d),
+		OutboundEmailAddress:       buildSdkQueueEmailAddress(d),
+		EnableTranscription:        &enableTranscription,
+		EnableManualAssignment:     &enableManualAssignment,
+	}
+}
+
 func buildSdkMediaSettings(d *schema.ResourceData) *map[string]platformclientv2.Mediasetting {
 	settings := make(map[string]platformclientv2.Mediasetting)
 
@@ -728,14 +990,95 @@ func buildSdkMediaSetting(settings []interface{}) platformclientv2.Mediasetting
 	}
 }
 
-func flattenMediaSetting(settings platformclientv2.Mediasetting) []interface{} {
+func flattenMediaSetting(settings platformclientv2.Mediasetting, extensions *queueMediaSettingExtensions) []interface{} {
 	settingsMap := make(map[string]interface{})
 	settingsMap["alerting_timeout_sec"] = *settings.AlertingTimeoutSeconds
 	settingsMap["service_level_percentage"] = *settings.ServiceLevel.Percentage
 	settingsMap["service_level_duration_ms"] = *settings.ServiceLevel.DurationMs
+	if extensions != nil {
+		if extensions.EnableAutoAnswer != nil {
+			settingsMap["enable_auto_answer"] = *extensions.EnableAutoAnswer
+		}
+		if extensions.AutoAnswerAlertToneSeconds != nil {
+			settingsMap["auto_answer_alert_tone_seconds"] = *extensions.AutoAnswerAlertToneSeconds
+		}
+		if extensions.ManualAnswerAlertToneSeconds != nil {
+			settingsMap["manual_answer_alert_tone_seconds"] = *extensions.ManualAnswerAlertToneSeconds
+		}
+		if extensions.Mode != nil {
+			settingsMap["mode"] = *extensions.Mode
+		}
+		if extensions.EnableAutoDialAndEnd != nil {
+			settingsMap["enable_auto_dial_and_end"] = *extensions.EnableAutoDialAndEnd
+		}
+		if extensions.AutoDialDelaySeconds != nil {
+			settingsMap["auto_dial_delay_seconds"] = *extensions.AutoDialDelaySeconds
+		}
+		if extensions.AutoEndDelaySeconds != nil {
+			settingsMap["auto_end_delay_seconds"] = *extensions.AutoEndDelaySeconds
+		}
+	}
 	return []interface{}{settingsMap}
 }
 
+func getQueueMediaSettingExtensions(extensions *queueExtensions, mediaType string) *queueMediaSettingExtensions {
+	if extensions.MediaSettings == nil {
+		return nil
+	}
+	if settings, ok := (*extensions.MediaSettings)[mediaType]; ok {
+		return &settings
+	}
+	return nil
+}
+
+// buildQueueMediaSettingExtensions returns the media settings not supported by platformclientv2.Mediasetting.
+// Zero values are not sent so the API defaults are used.
+func buildQueueMediaSettingExtensions(d *schema.ResourceData) *map[string]queueMediaSettingExtensions {
+	mediaSettingsAttrs := map[string]string{
+		mediaSettingsKeyCall:     "media_settings_call",
+		mediaSettingsKeyCallback: "media_settings_callback",
+		mediaSettingsKeyChat:     "media_settings_chat",
+		mediaSettingsKeyEmail:    "media_settings_email",
+		mediaSettingsKeyMessage:  "media_settings_message",
+		mediaSettingsKeySocial:   "media_settings_social",
+		mediaSettingsKeyVideo:    "media_settings_video",
+	}
+
+	settings := make(map[string]queueMediaSettingExtensions)
+	for mediaType, attr := range mediaSettingsAttrs {
+		mediaSettings := d.Get(attr).([]interface{})
+		if len(mediaSettings) == 0 || mediaSettings[0] == nil {
+			continue
+		}
+		settingsMap := mediaSettings[0].(map[string]interface{})
+
+		var extensions queueMediaSettingExtensions
+		if enableAutoAnswer, ok := settingsMap["enable_auto_answer"].(bool); ok {
+			extensions.EnableAutoAnswer = &enableAutoAnswer
+		}
+		if autoAnswerTone, ok := settingsMap["auto_answer_alert_tone_seconds"].(float64); ok && autoAnswerTone > 0 {
+			extensions.AutoAnswerAlertToneSeconds = &autoAnswerTone
+		}
+		if manualAnswerTone, ok := settingsMap["manual_answer_alert_tone_seconds"].(float64); ok && manualAnswerTone > 0 {
+			extensions.ManualAnswerAlertToneSeconds = &manualAnswerTone
+		}
+		if mode, ok := settingsMap["mode"].(string); ok && mode != "" {
+			extensions.Mode = &mode
+		}
+		if enableAutoDialAndEnd, ok := settingsMap["enable_auto_dial_and_end"].(bool); ok {
+			extensions.EnableAutoDialAndEnd = &enableAutoDialAndEnd
+		}
+		if autoDialDelay, ok := settingsMap["auto_dial_delay_seconds"].(int); ok && autoDialDelay > 0 {
+			extensions.AutoDialDelaySeconds = &autoDialDelay
+		}
+		if autoEndDelay, ok := settingsMap["auto_end_delay_seconds"].(int); ok && autoEndDelay > 0 {
+			extensions.AutoEndDelaySeconds = &autoEndDelay
+		}
+		settings[mediaType] = extensions
+	}
+	return &settings
+}
+
 func buildSdkRoutingRules(d *schema.ResourceData) *[]platformclientv2.Routingrule {
 	var routingRules []platformclientv2.Routingrule
 	if configRoutingRules, ok := d.GetOk("routing_rules"); ok {
@@ -933,7 +1276,7 @@ func flattenQueueEmailAddress(settings platformclientv2.Queueemailaddress) map[s
 	return settingsMap
 }
 
-func updateQueueWrapupCodes(d *schema.ResourceData, routingAPI *platformclientv2.RoutingApi) diag.Diagnostics {
+func updateQueueWrapupCodes(d *schema.ResourceData, routingAPI routingProxy) diag.Diagnostics {
 	if d.HasChange("wrapup_codes") {
 		if codesConfig := d.Get("wrapup_codes"); codesConfig != nil {
 			// Get existing codes
@@ -976,7 +1319,7 @@ func updateQueueWrapupCodes(d *schema.ResourceData, routingAPI *platformclientv2
 	return nil
 }
 
-func addWrapupCodesInChunks(queueID string, codesToAdd []string, api *platformclientv2.RoutingApi) diag.Diagnostics {
+func addWrapupCodesInChunks(queueID string, codesToAdd []string, api routingProxy) diag.Diagnostics {
 	// API restricts wraup code adds to 100 per call
 	const maxBatchSize = 100
 	for i := 0; i < len(codesToAdd); i += maxBatchSize {
@@ -999,7 +1342,7 @@ func addWrapupCodesInChunks(queueID string, codesToAdd []string, api *platformcl
 	return nil
 }
 
-func getRoutingQueueWrapupCodes(queueID string, api *platformclientv2.RoutingApi) ([]platformclientv2.Wrapupcode, diag.Diagnostics) {
+func getRoutingQueueWrapupCodes(queueID string, api routingProxy) ([]platformclientv2.Wrapupcode, diag.Diagnostics) {
 	const maxPageSize = 100
 
 	var codes []platformclientv2.Wrapupcode
@@ -1017,7 +1360,143 @@ func getRoutingQueueWrapupCodes(queueID string, api *platformclientv2.RoutingApi
 	}
 }
 
-func updateQueueMembers(d *schema.ResourceData, routingAPI *platformclientv2.RoutingApi) diag.Diagnostics {
+func buildQueueExtensions(d *schema.ResourceData) queueExtensions {
+	return queueExtensions{
+		MemberGroups:      buildSdkQueueMemberGroups(d),
+		AgentOwnedRouting: buildSdkQueueAgentOwnedRouting(d),
+		DirectRouting:     buildQueueDirectRouting(d),
+		MediaSettings:     buildQueueMediaSettingExtensions(d),
+	}
+}
+
+func buildSdkQueueAgentOwnedRouting(d *schema.ResourceData) *platformclientv2.Agentownedrouting {
+	settings := d.Get("agent_owned_routing").([]interface{})
+	if len(settings) == 0 || settings[0] == nil {
+		return nil
+	}
+	settingsMap := settings[0].(map[string]interface{})
+
+	enableCallbacks := settingsMap["enable_agent_owned_callbacks"].(bool)
+	agentOwnedRouting := &platformclientv2.Agentownedrouting{EnableAgentOwnedCallbacks: &enableCallbacks}
+	if maxHours := settingsMap["max_owned_callback_hours"].(int); maxHours > 0 {
+		agentOwnedRouting.MaxOwnedCallbackHours = &maxHours
+	}
+	if maxDelayHours := settingsMap["max_owned_callback_delay_hours"].(int); maxDelayHours > 0 {
+		agentOwnedRouting.MaxOwnedCallbackDelayHours = &maxDelayHours
+	}
+	return agentOwnedRouting
+}
+
+func flattenQueueAgentOwnedRouting(settings platformclientv2.Agentownedrouting) []interface{} {
+	settingsMap := make(map[string]interface{})
+	if settings.EnableAgentOwnedCallbacks != nil {
+		settingsMap["enable_agent_owned_callbacks"] = *settings.EnableAgentOwnedCallbacks
+	}
+	if settings.MaxOwnedCallbackHours != nil {
+		settingsMap["max_owned_callback_hours"] = *settings.MaxOwnedCallbackHours
+	}
+	if settings.MaxOwnedCallbackDelayHours != nil {
+		settingsMap["max_owned_callback_delay_hours"] = *settings.MaxOwnedCallbackDelayHours
+	}
+	return []interface{}{settingsMap}
+}
+
+func buildQueueDirectRouting(d *schema.ResourceData) *queueDirectRouting {
+	settings := d.Get("direct_routing").([]interface{})
+	if len(settings) == 0 || settings[0] == nil {
+		return nil
+	}
+	settingsMap := settings[0].(map[string]interface{})
+
+	waitForAgent := settingsMap["wait_for_agent"].(bool)
+	agentWaitSeconds := settingsMap["agent_wait_seconds"].(int)
+	callUseAgentAddress := settingsMap["call_use_agent_address_outbound"].(bool)
+	emailUseAgentAddress := settingsMap["email_use_agent_address_outbound"].(bool)
+	messageUseAgentAddress := settingsMap["message_use_agent_address_outbound"].(bool)
+
+	directRouting := &queueDirectRouting{
+		CallMediaSettings:    &queueDirectRoutingMediaSettings{UseAgentAddressOutbound: &callUseAgentAddress},
+		EmailMediaSettings:   &queueDirectRoutingMediaSettings{UseAgentAddressOutbound: &emailUseAgentAddress},
+		MessageMediaSettings: &queueDirectRoutingMediaSettings{UseAgentAddressOutbound: &messageUseAgentAddress},
+		WaitForAgent:         &waitForAgent,
+		AgentWaitSeconds:     &agentWaitSeconds,
+	}
+	if backupQueueID := settingsMap["backup_queue_id"].(string); backupQueueID != "" {
+		directRouting.BackupQueueId = &backupQueueID
+	}
+	return directRouting
+}
+
+func flattenQueueDirectRouting(settings queueDirectRouting) []interface{} {
+	settingsMap := make(map[string]interface{})
+	if settings.BackupQueueId != nil {
+		settingsMap["backup_queue_id"] = *settings.BackupQueueId
+	}
+	if settings.WaitForAgent != nil {
+		settingsMap["wait_for_agent"] = *settings.WaitForAgent
+	}
+	if settings.AgentWaitSeconds != nil {
+		settingsMap["agent_wait_seconds"] = *settings.AgentWaitSeconds
+	}
+	if settings.CallMediaSettings != nil && settings.CallMediaSettings.UseAgentAddressOutbound != nil {
+		settingsMap["call_use_agent_address_outbound"] = *settings.CallMediaSettings.UseAgentAddressOutbound
+	}
+	if settings.EmailMediaSettings != nil && settings.EmailMediaSettings.UseAgentAddressOutbound != nil {
+		settingsMap["email_use_agent_address_outbound"] = *settings.EmailMediaSettings.UseAgentAddressOutbound
+	}
+	if settings.MessageMediaSettings != nil && settings.MessageMediaSettings.UseAgentAddressOutbound != nil {
+		settingsMap["message_use_agent_address_outbound"] = *settings.MessageMediaSettings.UseAgentAddressOutbound
+	}
+	return []interface{}{settingsMap}
+}
+
+func buildSdkQueueMemberGroups(d *schema.ResourceData) *[]platformclientv2.Membergroup {
+	memberGroups := []platformclientv2.Membergroup{}
+	if groups, ok := d.Get("member_groups").(*schema.Set); ok {
+		for _, group := range groups.List() {
+			groupMap := group.(map[string]interface{})
+			groupID := groupMap["group_id"].(string)
+			groupType := groupMap["type"].(string)
+			memberGroups = append(memberGroups, platformclientv2.Membergroup{Id: &groupID, VarType: &groupType})
+		}
+	}
+	if skillGroups, ok := d.Get("skill_groups").(*schema.Set); ok {
+		for _, skillGroupID := range *setToStringList(skillGroups) {
+			skillGroupID := skillGroupID
+			groupType := memberGroupTypeSkillGroup
+			memberGroups = append(memberGroups, platformclientv2.Membergroup{Id: &skillGroupID, VarType: &groupType})
+		}
+	}
+	return &memberGroups
+}
+
+func flattenQueueMemberGroups(sdkMemberGroups *[]platformclientv2.Membergroup) (*schema.Set, *schema.Set) {
+	groupSet := schema.NewSet(schema.HashResource(queueMemberGroupResource), []interface{}{})
+	skillGroupSet := schema.NewSet(schema.HashString, []interface{}{})
+	if sdkMemberGroups == nil {
+		return groupSet, skillGroupSet
+	}
+	for _, memberGroup := range *sdkMemberGroups {
+		if memberGroup.Id == nil || memberGroup.VarType == nil {
+			continue
+		}
+		if *memberGroup.VarType == memberGroupTypeSkillGroup {
+			skillGroupSet.Add(*memberGroup.Id)
+		} else {
+			groupSet.Add(map[string]interface{}{
+				"group_id": *memberGroup.Id,
+				"type":     *memberGroup.VarType,
+			})
+		}
+	}
+	return groupSet, skillGroupSet
+}
+
+func updateQueueMembers(d *schema.ResourceData, routingAPI routingProxy) diag.Diagnostics {
+	if d.Get("ignore_members").(bool) {
+		// Members are managed by genesyscloud_routing_queue_member resources
+		return nil
+	}
 	if d.HasChange("members") {
 		if members := d.Get("members"); members != nil {
 			log.Printf("Updating members for Queue %s", d.Get("name"))
@@ -1082,7 +1561,7 @@ func updateQueueMembers(d *schema.ResourceData, routingAPI *platformclientv2.Rou
 	return nil
 }
 
-func updateMembersInChunks(queueID string, membersToUpdate []string, remove bool, api *platformclientv2.RoutingApi) diag.Diagnostics {
+func updateMembersInChunks(queueID string, membersToUpdate []string, remove bool, api routingProxy) diag.Diagnostics {
 	// API restricts member adds/removes to 100 per call
 	const maxBatchSize = 100
 	for i := 0; i < len(membersToUpdate); i += maxBatchSize {
@@ -1105,7 +1584,7 @@ func updateMembersInChunks(queueID string, membersToUpdate []string, remove bool
 	return nil
 }
 
-func updateQueueUserRingNum(queueID string, userID string, ringNum int, api *platformclientv2.RoutingApi) diag.Diagnostics {
+func updateQueueUserRingNum(queueID string, userID string, ringNum int, api routingProxy) diag.Diagnostics {
 	_, err := api.PatchRoutingQueueMember(queueID, userID, platformclientv2.Queuemember{
 		Id:         &userID,
 		RingNumber: &ringNum,
@@ -1116,12 +1595,12 @@ func updateQueueUserRingNum(queueID string, userID string, ringNum int, api *pla
 	return nil
 }
 
-func getRoutingQueueMembers(queueID string, api *platformclientv2.RoutingApi) ([]platformclientv2.Queuemember, diag.Diagnostics) {
+func getRoutingQueueMembers(queueID string, api routingProxy) ([]platformclientv2.Queuemember, diag.Diagnostics) {
 	const maxPageSize = 100
 
 	var members []platformclientv2.Queuemember
 	for pageNum := 1; ; pageNum++ {
-		users, _, err := sdkGetRoutingQueueMembers(queueID, pageNum, maxPageSize, api)
+		users, _, err := api.GetRoutingQueueMembers(queueID, pageNum, maxPageSize, "")
 		if err != nil {
 			return nil, diag.Errorf("Failed to query users for queue %s: %s", queueID, err)
 		}
@@ -1129,54 +1608,16 @@ func getRoutingQueueMembers(queueID string, api *platformclientv2.RoutingApi) ([
 			return members, nil
 		}
 		for _, user := range *users.Entities {
+			// Users added through member groups are managed with the groups
+			if user.MemberBy != nil && *user.MemberBy != "user" {
+				continue
+			}
 			members = append(members, user)
 		}
 	}
 }
 
-func sdkGetRoutingQueueMembers(queueID string, pageNumber int, pageSize int, api *platformclientv2.RoutingApi) (*platformclientv2.Queuememberentitylisting, *platformclientv2.APIResponse, error) {
-	// SDK does not support nil values for boolean query params yet, so we must manually construct this HTTP request for now
-	apiClient := &api.Configuration.APIClient
-
-	// create path and map variables
-	path := api.Configuration.BasePath + "/api/v2/routing/queues/{queueId}/members"
-	path = strings.Replace(path, "{queueId}", fmt.Sprintf("%v", queueID), -1)
-
-	headerParams := make(map[string]string)
-	queryParams := make(map[string]string)
-	formParams := url.Values{}
-	var postBody interface{}
-	var postFileName string
-	var fileBytes []byte
-
-	// oauth required
-	if api.Configuration.AccessToken != "" {
-		headerParams["Authorization"] = "Bearer " + api.Configuration.AccessToken
-	}
-	// add default headers if any
-	for key := range api.Configuration.DefaultHeader {
-		headerParams[key] = api.Configuration.DefaultHeader[key]
-	}
-
-	queryParams["pageSize"] = apiClient.ParameterToString(pageSize, "")
-	queryParams["pageNumber"] = apiClient.ParameterToString(pageNumber, "")
-
-	headerParams["Content-Type"] = "application/json"
-	headerParams["Accept"] = "application/json"
-
-	var successPayload *platformclientv2.Queuememberentitylisting
-	response, err := apiClient.CallAPI(path, http.MethodGet, postBody, headerParams, queryParams, formParams, postFileName, fileBytes)
-	if err != nil {
-		// Nothing special to do here, but do avoid processing the response
-	} else if err == nil && response.Error != nil {
-		err = fmt.Errorf(response.ErrorMessage)
-	} else {
-		err = json.Unmarshal([]byte(response.RawBody), &successPayload)
-	}
-	return successPayload, response, err
-}
-
-func flattenQueueMembers(queueID string, api *platformclientv2.RoutingApi) (*schema.Set, diag.Diagnostics) {
+func flattenQueueMembers(queueID string, api routingProxy) (*schema.Set, diag.Diagnostics) {
 	members, err := getRoutingQueueMembers(queueID, api)
 	if err != nil {
 		return nil, err
@@ -1193,7 +1634,7 @@ func flattenQueueMembers(queueID string, api *platformclientv2.RoutingApi) (*sch
 	return memberSet, nil
 }
 
-func flattenQueueWrapupCodes(queueID string, api *platformclientv2.RoutingApi) (*schema.Set, diag.Diagnostics) {
+func flattenQueueWrapupCodes(queueID string, api routingProxy) (*schema.Set, diag.Diagnostics) {
 	const maxPageSize = 100
 	var codeIds []string
 	for pageNum := 1; ; pageNum++ {
@@ -0,0 +1,280 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+var (
+	conditionalGroupRoutingGroupResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"member_group_id": {
+				Description: "ID of the group, team, or skill group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"member_group_type": {
+				Description:  "Type of the member group (GROUP | TEAM | SKILLGROUP).",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{memberGroupTypeGroup, memberGroupTypeTeam, memberGroupTypeSkillGroup}, false),
+			},
+		},
+	}
+
+	conditionalGroupRoutingRuleResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"evaluated_queue_id": {
+				Description: "ID of the queue whose metric is evaluated. The first rule always evaluates the queue being routed to, so this should only be set on later rules.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"metric": {
+				Description: "Queue metric being evaluated, e.g. EstimatedWaitTime.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "EstimatedWaitTime",
+			},
+			"operator": {
+				Description:  "Operator that compares the metric to the condition value (GreaterThan | GreaterThanOrEqualTo | LessThan | LessThanOrEqualTo).",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"GreaterThan", "GreaterThanOrEqualTo", "LessThan", "LessThanOrEqualTo"}, false),
+			},
+			"condition_value": {
+				Description:  "Value the metric is compared to.",
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"wait_seconds": {
+				Description:  "Seconds to wait in this rule before moving to the next rule.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntBetween(0, 259200),
+			},
+			"groups": {
+				Description: "Groups whose members are routed to when this rule's condition is met.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        conditionalGroupRoutingGroupResource,
+			},
+		},
+	}
+)
+
+func getAllRoutingQueueConditionalGroupRouting(ctx context.Context, clientConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
+	queues, diagErr := getAllRoutingQueues(ctx, clientConfig)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	// Only queues with rules are exported
+	resources := make(ResourceIDMetaMap)
+	routingAPI := &sdkRoutingProxy{platformclientv2.NewRoutingApiWithConfig(clientConfig)}
+	for queueID, queueMeta := range queues {
+		routing, _, getErr := routingAPI.GetRoutingQueueConditionalGroupRouting(queueID)
+		if getErr != nil {
+			return nil, diag.Errorf("Failed to get conditional group routing for queue %s: %v", queueID, getErr)
+		}
+		if routing != nil && routing.Rules != nil && len(*routing.Rules) > 0 {
+			resources[queueID] = queueMeta
+		}
+	}
+	return resources, nil
+}
+
+func routingQueueConditionalGroupRoutingExporter() *ResourceExporter {
+	return &ResourceExporter{
+		GetResourcesFunc: getAllWithPooledClient(getAllRoutingQueueConditionalGroupRouting),
+		RefAttrs: map[string]*RefAttrSettings{
+			"queue_id": {RefType: "genesyscloud_routing_queue"},
+			// Rules are ordered, so IDs of objects that are not exported are kept to leave every rule in place
+			"rules.evaluated_queue_id": {RefType: "genesyscloud_routing_queue", KeepUnresolved: true},
+			// Team and skill group IDs are kept as-is. Ref types not yet defined
+			"rules.groups.member_group_id": {
+				RefType:         "genesyscloud_group",
+				ConditionAttr:   "member_group_type",
+				ConditionValues: []string{memberGroupTypeGroup},
+				KeepUnresolved:  true,
+			},
+		},
+	}
+}
+
+func resourceRoutingQueueConditionalGroupRouting() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Routing Queue Conditional Group Routing. Manages the ordered rules that route a queue's conversations to other groups based on queue metrics.",
+
+		CreateContext: createWithPooledClient(createRoutingQueueConditionalGroupRouting),
+		ReadContext:   readWithPooledClient(readRoutingQueueConditionalGroupRouting),
+		UpdateContext: updateWithPooledClient(updateRoutingQueueConditionalGroupRouting),
+		DeleteContext: deleteWithPooledClient(deleteRoutingQueueConditionalGroupRouting),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue the rules apply to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"rules": {
+				Description: "Rules evaluated in order while a conversation waits in the queue.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    5,
+				Elem:        conditionalGroupRoutingRuleResource,
+			},
+		},
+	}
+}
+
+func createRoutingQueueConditionalGroupRouting(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueID := d.Get("queue_id").(string)
+	d.SetId(queueID)
+	return updateRoutingQueueConditionalGroupRouting(ctx, d, meta)
+}
+
+func readRoutingQueueConditionalGroupRouting(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routingAPI := meta.(*providerMeta).routingProxy()
+
+	log.Printf("Reading conditional group routing for queue %s", d.Id())
+	return withRetriesForRead(ctx, 30*time.Second, d, func() *resource.RetryError {
+		routing, resp, getErr := routingAPI.GetRoutingQueueConditionalGroupRouting(d.Id())
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to read conditional group routing for queue %s: %s", d.Id(), getErr))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to read conditional group routing for queue %s: %s", d.Id(), getErr))
+		}
+
+		d.Set("queue_id", d.Id())
+		if routing != nil && routing.Rules != nil {
+			d.Set("rules", flattenConditionalGroupRoutingRules(*routing.Rules))
+		} else {
+			d.Set("rules", nil)
+		}
+
+		log.Printf("Read conditional group routing for queue %s", d.Id())
+		return nil
+	})
+}
+
+func updateRoutingQueueConditionalGroupRouting(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routingAPI := meta.(*providerMeta).routingProxy()
+
+	log.Printf("Updating conditional group routing for queue %s", d.Id())
+	rules := buildSdkConditionalGroupRoutingRules(d)
+	_, _, err := routingAPI.PutRoutingQueueConditionalGroupRouting(d.Id(), conditionalGroupRouting{Rules: &rules})
+	if err != nil {
+		return diag.Errorf("Failed to update conditional group routing for queue %s: %s", d.Id(), err)
+	}
+
+	log.Printf("Updated conditional group routing for queue %s", d.Id())
+	return readRoutingQueueConditionalGroupRouting(ctx, d, meta)
+}
+
+func deleteRoutingQueueConditionalGroupRouting(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routingAPI := meta.(*providerMeta).routingProxy()
+
+	// Does not delete the queue. The queue's rules are removed.
+	log.Printf("Removing conditional group routing for queue %s", d.Id())
+	_, resp, err := routingAPI.PutRoutingQueueConditionalGroupRouting(d.Id(), conditionalGroupRouting{Rules: &[]conditionalGroupRoutingRule{}})
+	if err != nil && !isStatus404(resp) {
+		return diag.Errorf("Failed to remove conditional group routing for queue %s: %s", d.Id(), err)
+	}
+	log.Printf("Removed conditional group routing for queue %s", d.Id())
+	return nil
+}
+
+func buildSdkConditionalGroupRoutingRules(d *schema.ResourceData) []conditionalGroupRoutingRule {
+	rules := []conditionalGroupRoutingRule{}
+	for _, configRule := range d.Get("rules").([]interface{}) {
+		ruleMap := configRule.(map[string]interface{})
+		metric := ruleMap["metric"].(string)
+		operator := ruleMap["operator"].(string)
+		conditionValue := ruleMap["condition_value"].(float64)
+		waitSeconds := ruleMap["wait_seconds"].(int)
+
+		rule := conditionalGroupRoutingRule{
+			Metric:         &metric,
+			Operator:       &operator,
+			ConditionValue: &conditionValue,
+			WaitSeconds:    &waitSeconds,
+		}
+		if evaluatedQueueID := ruleMap["evaluated_queue_id"].(string); evaluatedQueueID != "" {
+			rule.EvaluatedQueue = &platformclientv2.Domainentityref{Id: &evaluatedQueueID}
+		}
+
+		groups := []conditionalGroupRoutingGroup{}
+		if groupSet, ok := ruleMap["groups"].(*schema.Set); ok {
+			for _, configGroup := range groupSet.List() {
+				groupMap := configGroup.(map[string]interface{})
+				groupID := groupMap["member_group_id"].(string)
+				groupType := groupMap["member_group_type"].(string)
+				groups = append(groups, conditionalGroupRoutingGroup{
+					Member:     &platformclientv2.Domainentityref{Id: &groupID},
+					MemberType: &groupType,
+				})
+			}
+		}
+		rule.Groups = &groups
+
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenConditionalGroupRoutingRules(sdkRules []conditionalGroupRoutingRule) []interface{} {
+	rules := make([]interface{}, len(sdkRules))
+	for i, sdkRule := range sdkRules {
+		ruleMap := make(map[string]interface{})
+		if sdkRule.EvaluatedQueue != nil && sdkRule.EvaluatedQueue.Id != nil {
+			ruleMap["evaluated_queue_id"] = *sdkRule.EvaluatedQueue.Id
+		}
+		if sdkRule.Metric != nil {
+			ruleMap["metric"] = *sdkRule.Metric
+		}
+		if sdkRule.Operator != nil {
+			ruleMap["operator"] = *sdkRule.Operator
+		}
+		if sdkRule.ConditionValue != nil {
+			ruleMap["condition_value"] = *sdkRule.ConditionValue
+		}
+		if sdkRule.WaitSeconds != nil {
+			ruleMap["wait_seconds"] = *sdkRule.WaitSeconds
+		}
+
+		groupSet := schema.NewSet(schema.HashResource(conditionalGroupRoutingGroupResource), []interface{}{})
+		if sdkRule.Groups != nil {
+			for _, group := range *sdkRule.Groups {
+				if group.Member == nil || group.Member.Id == nil || group.MemberType == nil {
+					continue
+				}
+				groupSet.Add(map[string]interface{}{
+					"member_group_id":   *group.Member.Id,
+					"member_group_type": *group.MemberType,
+				})
+			}
+		}
+		ruleMap["groups"] = groupSet
+
+		rules[i] = ruleMap
+	}
+	return rules
+}
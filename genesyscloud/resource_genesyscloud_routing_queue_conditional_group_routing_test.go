@@ -0,0 +1,159 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccResourceRoutingQueueConditionalGroupRouting(t *testing.T) {
+	var (
+		queueResource1       = "test-cgr-queue-1"
+		queueResource2       = "test-cgr-queue-2"
+		queueName1           = "Terraform Test Queue CGR1-" + uuid.NewString()
+		queueName2           = "Terraform Test Queue CGR2-" + uuid.NewString()
+		groupResource        = "test-cgr-group"
+		groupName            = "Terraform Test CGR Group-" + uuid.NewString()
+		conditionalResource  = "test-cgr"
+		conditionalFullName  = "genesyscloud_routing_queue_conditional_group_routing." + conditionalResource
+		groupReference       = "genesyscloud_group." + groupResource + ".id"
+		evaluatedQueueRef    = "genesyscloud_routing_queue." + queueResource2 + ".id"
+		baseConfig           = generateRoutingQueueResourceBasic(queueResource1, queueName1) + generateRoutingQueueResourceBasic(queueResource2, queueName2) + generateBasicGroupResource(groupResource, groupName)
+		conditionalQueueAttr = "genesyscloud_routing_queue." + queueResource1 + ".id"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create with a single rule
+				Config: baseConfig + generateRoutingQueueConditionalGroupRouting(
+					conditionalResource,
+					conditionalQueueAttr,
+					generateConditionalGroupRoutingRule(nullValue, "GreaterThan", "30", "5", groupReference),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(conditionalFullName, "queue_id", "genesyscloud_routing_queue."+queueResource1, "id"),
+					resource.TestCheckResourceAttr(conditionalFullName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(conditionalFullName, "rules.0.operator", "GreaterThan"),
+					resource.TestCheckResourceAttr(conditionalFullName, "rules.0.condition_value", "30"),
+					resource.TestCheckResourceAttr(conditionalFullName, "rules.0.wait_seconds", "5"),
+					resource.TestCheckResourceAttr(conditionalFullName, "rules.0.metric", "EstimatedWaitTime"),
+				),
+			},
+			{
+				// Add a rule evaluating another queue
+				Config: baseConfig + generateRoutingQueueConditionalGroupRouting(
+					conditionalResource,
+					conditionalQueueAttr,
+					generateConditionalGroupRoutingRule(nullValue, "GreaterThan", "30", "5", groupReference),
+					generateConditionalGroupRoutingRule(evaluatedQueueRef, "LessThan", "10", "20", groupReference),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(conditionalFullName, "rules.#", "2"),
+					resource.TestCheckResourceAttrPair(conditionalFullName, "rules.1.evaluated_queue_id", "genesyscloud_routing_queue."+queueResource2, "id"),
+					resource.TestCheckResourceAttr(conditionalFullName, "rules.1.operator", "LessThan"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      conditionalFullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func generateRoutingQueueConditionalGroupRouting(resourceID string, queueID string, rules ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_queue_conditional_group_routing" "%s" {
+		queue_id = %s
+		%s
+	}
+	`, resourceID, queueID, strings.Join(rules, "\n"))
+}
+
+func generateConditionalGroupRoutingRule(evaluatedQueueID string, operator string, conditionValue string, waitSeconds string, groupID string) string {
+	return fmt.Sprintf(`rules {
+			evaluated_queue_id = %s
+			operator = "%s"
+			condition_value = %s
+			wait_seconds = %s
+			groups {
+				member_group_id = %s
+				member_group_type = "GROUP"
+			}
+		}
+		`, evaluatedQueueID, operator, conditionValue, waitSeconds, groupID)
+}
+
+func TestUnitRoutingQueueConditionalGroupRouting(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	ctx := context.Background()
+	routingResource := resourceRoutingQueueConditionalGroupRouting()
+
+	queue := fakeAPI.create("/api/v2/routing/queues", map[string]interface{}{"name": "Conditional Routing Queue"})
+	otherQueue := fakeAPI.create("/api/v2/routing/queues", map[string]interface{}{"name": "Evaluated Queue"})
+	queueID := queue["id"].(string)
+	rulesPath := "/api/v2/routing/queues/" + queueID + "/conditionalgroup/routing"
+
+	d := schema.TestResourceDataRaw(t, routingResource.Schema, map[string]interface{}{
+		"queue_id": queueID,
+		"rules": []interface{}{
+			map[string]interface{}{
+				"operator":        "GreaterThan",
+				"condition_value": 30.0,
+				"groups": []interface{}{
+					map[string]interface{}{"member_group_id": "group-id", "member_group_type": "GROUP"},
+				},
+			},
+			map[string]interface{}{
+				"evaluated_queue_id": otherQueue["id"],
+				"operator":           "LessThan",
+				"condition_value":    10.0,
+				"wait_seconds":       20,
+				"groups": []interface{}{
+					map[string]interface{}{"member_group_id": "skill-group-id", "member_group_type": "SKILLGROUP"},
+				},
+			},
+		},
+	})
+	if diagErr := routingResource.CreateContext(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to create conditional group routing: %v", diagErr)
+	}
+	if d.Id() != queueID {
+		t.Errorf("Expected ID %s, got %s", queueID, d.Id())
+	}
+
+	stored, _ := fakeAPI.subResources[rulesPath].(map[string]interface{})
+	if rules, _ := stored["rules"].([]interface{}); len(rules) != 2 {
+		t.Fatalf("Expected 2 rules to be set on the queue, got %v", stored)
+	}
+
+	// Rules are read back in order
+	if d.Get("rules.1.evaluated_queue_id") != otherQueue["id"] || d.Get("rules.1.wait_seconds") != 20 || d.Get("rules.0.metric") != "EstimatedWaitTime" {
+		t.Errorf("Unexpected rules read from the API: %v", d.Get("rules"))
+	}
+	groups := d.Get("rules.1.groups").(*schema.Set)
+	if !groups.Contains(map[string]interface{}{"member_group_id": "skill-group-id", "member_group_type": "SKILLGROUP"}) {
+		t.Errorf("Unexpected groups read from the API: %v", groups.List())
+	}
+
+	if diagErr := routingResource.DeleteContext(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to delete conditional group routing: %v", diagErr)
+	}
+	stored, _ = fakeAPI.subResources[rulesPath].(map[string]interface{})
+	if rules, _ := stored["rules"].([]interface{}); len(rules) != 0 {
+		t.Errorf("Expected rules to be removed from the queue, got %v", rules)
+	}
+	if fakeAPI.get("/api/v2/routing/queues", queueID) == nil {
+		t.Error("Expected the queue not to be deleted")
+	}
+}
@@ -0,0 +1,187 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+func createQueueMemberId(queueID string, userID string) string {
+	return strings.Join([]string{queueID, userID}, "/")
+}
+
+func splitQueueMemberId(memberID string) (string, string) {
+	split := strings.SplitN(memberID, "/", 2)
+	if len(split) == 2 {
+		return split[0], split[1]
+	}
+	return "", ""
+}
+
+func resourceRoutingQueueMember() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Routing Queue Member. Manages a single user's membership in a queue without affecting other members. Set `ignore_members` on the queue's `genesyscloud_routing_queue` resource when using this resource.",
+
+		CreateContext: createWithPooledClient(createRoutingQueueMember),
+		ReadContext:   readWithPooledClient(readRoutingQueueMember),
+		UpdateContext: updateWithPooledClient(updateRoutingQueueMember),
+		DeleteContext: deleteWithPooledClient(deleteRoutingQueueMember),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "Queue ID. If this is changed, the user is moved to the new queue.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Description: "User ID. If this is changed, the new user replaces this user in the queue.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ring_num": {
+				Description:  "Ring number between 1 and 6 for this user in the queue.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
+		},
+	}
+}
+
+func createRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueID := d.Get("queue_id").(string)
+	userID := d.Get("user_id").(string)
+	ringNum := d.Get("ring_num").(int)
+
+	routingAPI := meta.(*providerMeta).routingProxy()
+
+	log.Printf("Adding user %s to queue %s", userID, queueID)
+	diagErr := updateMembersInChunks(queueID, []string{userID}, false, routingAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+	d.SetId(createQueueMemberId(queueID, userID))
+
+	if ringNum != 1 {
+		diagErr = updateQueueUserRingNum(queueID, userID, ringNum, routingAPI)
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Added user %s to queue %s", userID, queueID)
+	return readRoutingQueueMember(ctx, d, meta)
+}
+
+func readRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueID, userID := splitQueueMemberId(d.Id())
+	if queueID == "" {
+		return diag.Errorf("Invalid queue member ID %s. Expected <queue ID>/<user ID>", d.Id())
+	}
+
+	routingAPI := meta.(*providerMeta).routingProxy()
+	usersAPI := meta.(*providerMeta).usersProxy()
+
+	log.Printf("Reading user %s in queue %s", userID, queueID)
+	return withRetries(ctx, 30*time.Second, func() *resource.RetryError {
+		user, resp, getErr := usersAPI.GetUser(userID, nil, "", "")
+		if getErr != nil {
+			if isStatus404(resp) {
+				d.SetId("")
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to read user %s: %s", userID, getErr))
+		}
+
+		// Search by the user's name to avoid reading the entire membership of large queues
+		member, diagErr := getRoutingQueueMemberByName(queueID, userID, *user.Name, routingAPI)
+		if diagErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("%v", diagErr))
+		}
+		if member == nil {
+			if d.IsNewResource() {
+				// Membership changes may take time to be returned by the API
+				return resource.RetryableError(fmt.Errorf("User %s not yet found in queue %s", userID, queueID))
+			}
+			log.Printf("User %s is no longer a member of queue %s", userID, queueID)
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("queue_id", queueID)
+		d.Set("user_id", userID)
+		if member.RingNumber != nil {
+			d.Set("ring_num", *member.RingNumber)
+		}
+
+		log.Printf("Read user %s in queue %s", userID, queueID)
+		return nil
+	})
+}
+
+func updateRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueID := d.Get("queue_id").(string)
+	userID := d.Get("user_id").(string)
+
+	routingAPI := meta.(*providerMeta).routingProxy()
+
+	if d.HasChange("ring_num") {
+		log.Printf("Updating ring number for user %s in queue %s", userID, queueID)
+		diagErr := updateQueueUserRingNum(queueID, userID, d.Get("ring_num").(int), routingAPI)
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+	return readRoutingQueueMember(ctx, d, meta)
+}
+
+func deleteRoutingQueueMember(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueID := d.Get("queue_id").(string)
+	userID := d.Get("user_id").(string)
+
+	routingAPI := meta.(*providerMeta).routingProxy()
+
+	log.Printf("Removing user %s from queue %s", userID, queueID)
+	_, err := routingAPI.PostRoutingQueueMembers(queueID, []platformclientv2.Writableentity{{Id: &userID}}, true)
+	if err != nil {
+		return diag.Errorf("Failed to remove user %s from queue %s: %s", userID, queueID, err)
+	}
+	log.Printf("Removed user %s from queue %s", userID, queueID)
+	return nil
+}
+
+func getRoutingQueueMemberByName(queueID string, userID string, name string, api routingProxy) (*platformclientv2.Queuemember, diag.Diagnostics) {
+	const maxPageSize = 100
+	for pageNum := 1; ; pageNum++ {
+		members, resp, err := api.GetRoutingQueueMembers(queueID, pageNum, maxPageSize, name)
+		if err != nil {
+			if isStatus404(resp) {
+				// Queue no longer exists
+				return nil, nil
+			}
+			return nil, diag.Errorf("Failed to query users for queue %s: %s", queueID, err)
+		}
+		if members == nil || members.Entities == nil || len(*members.Entities) == 0 {
+			return nil, nil
+		}
+		for _, member := range *members.Entities {
+			if member.Id != nil && *member.Id == userID {
+				return &member, nil
+			}
+		}
+	}
+}
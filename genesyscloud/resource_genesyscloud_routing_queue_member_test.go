@@ -0,0 +1,176 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+func TestAccResourceRoutingQueueMember(t *testing.T) {
+	var (
+		queueResource       = "test-queue-member-queue"
+		queueName           = "Terraform Test Queue Member-" + uuid.NewString()
+		userResource        = "test-queue-member-user"
+		userEmail           = "terraform-member-" + uuid.NewString() + "@example.com"
+		userName            = "Queue Member Terraform"
+		queueMemberResource = "test-queue-member"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					"ignore_members = true",
+				) + generateBasicUserResource(
+					userResource,
+					userEmail,
+					userName,
+				) + generateRoutingQueueMemberResource(
+					queueMemberResource,
+					"genesyscloud_routing_queue."+queueResource+".id",
+					"genesyscloud_user."+userResource+".id",
+					nullValue,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_queue_member."+queueMemberResource, "queue_id", "genesyscloud_routing_queue."+queueResource, "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_queue_member."+queueMemberResource, "user_id", "genesyscloud_user."+userResource, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue_member."+queueMemberResource, "ring_num", "1"),
+				),
+			},
+			{
+				// Update ring number
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					"ignore_members = true",
+				) + generateBasicUserResource(
+					userResource,
+					userEmail,
+					userName,
+				) + generateRoutingQueueMemberResource(
+					queueMemberResource,
+					"genesyscloud_routing_queue."+queueResource+".id",
+					"genesyscloud_user."+userResource+".id",
+					"4",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue_member."+queueMemberResource, "ring_num", "4"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_routing_queue_member." + queueMemberResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func generateRoutingQueueMemberResource(resourceID string, queueID string, userID string, ringNum string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_queue_member" "%s" {
+		queue_id = %s
+		user_id = %s
+		ring_num = %s
+	}
+	`, resourceID, queueID, userID, ringNum)
+}
+
+// fakeQueueMemberUsersProxy is an in-memory users proxy that returns users by ID
+type fakeQueueMemberUsersProxy struct {
+	usersProxy
+}
+
+func (p *fakeQueueMemberUsersProxy) GetUser(userID string, expand []string, integrationPresenceSource string, state string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	name := "User " + userID
+	return &platformclientv2.User{Id: &userID, Name: &name}, nil, nil
+}
+
+func TestUnitRoutingQueueMember(t *testing.T) {
+	routingProxy := &fakeQueueMembersProxy{
+		ringNums: map[string]int{"other-user": 2},
+	}
+	meta := &providerMeta{proxies: &apiProxies{
+		routing: routingProxy,
+		users:   &fakeQueueMemberUsersProxy{},
+	}}
+	ctx := context.Background()
+	memberResource := resourceRoutingQueueMember()
+
+	d := schema.TestResourceDataRaw(t, memberResource.Schema, map[string]interface{}{
+		"queue_id": "queue-id",
+		"user_id":  "user-id",
+		"ring_num": 3,
+	})
+	if diagErr := createRoutingQueueMember(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to create queue member: %v", diagErr)
+	}
+	if d.Id() != "queue-id/user-id" {
+		t.Errorf("Expected ID queue-id/user-id, got %s", d.Id())
+	}
+	if routingProxy.ringNums["user-id"] != 3 {
+		t.Errorf("Expected user-id in ring 3, got %d", routingProxy.ringNums["user-id"])
+	}
+
+	if diagErr := deleteRoutingQueueMember(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to delete queue member: %v", diagErr)
+	}
+	if _, found := routingProxy.ringNums["user-id"]; found {
+		t.Error("Expected user-id to be removed from the queue")
+	}
+	if routingProxy.ringNums["other-user"] != 2 {
+		t.Error("Expected other members of the queue to be unchanged")
+	}
+
+	// Reading a removed member removes it from state
+	if diagErr := readRoutingQueueMember(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to read removed queue member: %v", diagErr)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected removed member to be removed from state, got ID %s", d.Id())
+	}
+}
+
+func TestUnitQueueIgnoreMembers(t *testing.T) {
+	routingProxy := &fakeQueueMembersProxy{
+		ringNums: map[string]int{"existing-user": 1},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceRoutingQueue().Schema, map[string]interface{}{
+		"name":           "Unit Test Queue",
+		"ignore_members": true,
+	})
+	d.SetId("queue-id")
+
+	if diagErr := updateQueueMembers(d, routingProxy); diagErr.HasError() {
+		t.Fatalf("Failed to update queue members: %v", diagErr)
+	}
+	if len(routingProxy.memberUpdates) != 0 || routingProxy.ringNums["existing-user"] != 1 {
+		t.Errorf("Expected members to be ignored, got updates %v", routingProxy.memberUpdates)
+	}
+}
+
+func TestUnitQueueIgnoreMembersConflictsWithMembers(t *testing.T) {
+	diags := resourceRoutingQueue().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "Unit Test Queue",
+		"ignore_members": true,
+		"members": []interface{}{
+			map[string]interface{}{"user_id": "user-id"},
+		},
+	}))
+	if !diags.HasError() {
+		t.Error("Expected members to conflict with ignore_members")
+	}
+}
@@ -1,13 +1,16 @@
 package genesyscloud
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
 )
@@ -345,6 +348,318 @@ func TestAccResourceRoutingQueueWrapupCodes(t *testing.T) {
 	})
 }
 
+func TestAccResourceRoutingQueueMemberGroups(t *testing.T) {
+	var (
+		queueResource  = "test-queue-groups"
+		queueName      = "Terraform Test Queue-" + uuid.NewString()
+		groupResource1 = "test-queue-group-1"
+		groupResource2 = "test-queue-group-2"
+		groupName1     = "Terraform Test Queue Group1-" + uuid.NewString()
+		groupName2     = "Terraform Test Queue Group2-" + uuid.NewString()
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create with a member group
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					generateQueueMemberGroup("genesyscloud_group."+groupResource1+".id"),
+				) + generateBasicGroupResource(groupResource1, groupName1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource, "member_groups.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource, "skill_groups.#", "0"),
+				),
+			},
+			{
+				// Update with another member group
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					generateQueueMemberGroup("genesyscloud_group."+groupResource1+".id"),
+					generateQueueMemberGroup("genesyscloud_group."+groupResource2+".id"),
+				) + generateBasicGroupResource(groupResource1, groupName1) +
+					generateBasicGroupResource(groupResource2, groupName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource, "member_groups.#", "2"),
+				),
+			},
+			{
+				// Remove member groups
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					"member_groups = []",
+				) + generateBasicGroupResource(groupResource1, groupName1) +
+					generateBasicGroupResource(groupResource2, groupName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource, "member_groups.#", "0"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_routing_queue." + queueResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func TestAccResourceRoutingQueueRoutingSettings(t *testing.T) {
+	var (
+		queueResource  = "test-queue-settings"
+		queueName      = "Terraform Test Queue-" + uuid.NewString()
+		backupResource = "test-queue-backup"
+		backupName     = "Terraform Test Backup Queue-" + uuid.NewString()
+		queueFullName  = "genesyscloud_routing_queue." + queueResource
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create with callback, agent owned, and direct routing settings
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					generateMediaSettings("media_settings_callback", "30", "0.7", "10000",
+						`mode = "AgentFirst"`,
+						"enable_auto_answer = true",
+					),
+					generateAgentOwnedRouting(trueValue, "2", "24"),
+					generateDirectRouting("genesyscloud_routing_queue."+backupResource+".id", trueValue, "30"),
+				) + generateRoutingQueueResourceBasic(backupResource, backupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(queueFullName, "media_settings_callback.0.mode", "AgentFirst"),
+					resource.TestCheckResourceAttr(queueFullName, "media_settings_callback.0.enable_auto_answer", trueValue),
+					resource.TestCheckResourceAttr(queueFullName, "agent_owned_routing.0.enable_agent_owned_callbacks", trueValue),
+					resource.TestCheckResourceAttr(queueFullName, "agent_owned_routing.0.max_owned_callback_hours", "2"),
+					resource.TestCheckResourceAttrPair(queueFullName, "direct_routing.0.backup_queue_id", "genesyscloud_routing_queue."+backupResource, "id"),
+					resource.TestCheckResourceAttr(queueFullName, "direct_routing.0.agent_wait_seconds", "30"),
+				),
+			},
+			{
+				// Update settings
+				Config: generateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+					generateMediaSettings("media_settings_callback", "30", "0.7", "10000",
+						`mode = "CustomerFirst"`,
+						"enable_auto_dial_and_end = true",
+						"auto_end_delay_seconds = 10",
+					),
+					generateAgentOwnedRouting(falseValue, "2", "24"),
+					generateDirectRouting(nullValue, falseValue, "60"),
+				) + generateRoutingQueueResourceBasic(backupResource, backupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(queueFullName, "media_settings_callback.0.mode", "CustomerFirst"),
+					resource.TestCheckResourceAttr(queueFullName, "media_settings_callback.0.enable_auto_dial_and_end", trueValue),
+					resource.TestCheckResourceAttr(queueFullName, "media_settings_callback.0.auto_end_delay_seconds", "10"),
+					resource.TestCheckResourceAttr(queueFullName, "agent_owned_routing.0.enable_agent_owned_callbacks", falseValue),
+					resource.TestCheckResourceAttr(queueFullName, "direct_routing.0.backup_queue_id", ""),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      queueFullName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+// fakeQueueMembersProxy is an in-memory routing proxy for queue member updates
+type fakeQueueMembersProxy struct {
+	routingProxy
+
+	ringNums      map[string]int
+	memberUpdates []int
+}
+
+func (p *fakeQueueMembersProxy) GetRoutingQueueMembers(queueID string, pageNumber int, pageSize int, name string) (*platformclientv2.Queuememberentitylisting, *platformclientv2.APIResponse, error) {
+	var userIDs []string
+	for userID := range p.ringNums {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+
+	members := []platformclientv2.Queuemember{}
+	for i := (pageNumber - 1) * pageSize; i < len(userIDs) && i < pageNumber*pageSize; i++ {
+		userID := userIDs[i]
+		ringNum := p.ringNums[userID]
+		members = append(members, platformclientv2.Queuemember{Id: &userID, RingNumber: &ringNum})
+	}
+	return &platformclientv2.Queuememberentitylisting{Entities: &members}, nil, nil
+}
+
+func (p *fakeQueueMembersProxy) PostRoutingQueueMembers(queueID string, body []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+	if len(body) > 100 {
+		return nil, fmt.Errorf("too many members in request: %d", len(body))
+	}
+	p.memberUpdates = append(p.memberUpdates, len(body))
+	for _, member := range body {
+		if remove {
+			delete(p.ringNums, *member.Id)
+		} else {
+			p.ringNums[*member.Id] = 1
+		}
+	}
+	return nil, nil
+}
+
+func (p *fakeQueueMembersProxy) PatchRoutingQueueMember(queueID string, memberID string, body platformclientv2.Queuemember) (*platformclientv2.APIResponse, error) {
+	p.ringNums[memberID] = *body.RingNumber
+	return nil, nil
+}
+
+func TestUnitQueueMembers(t *testing.T) {
+	proxy := &fakeQueueMembersProxy{
+		ringNums: map[string]int{"removed-user": 1, "kept-user": 1},
+	}
+
+	members := []interface{}{
+		map[string]interface{}{"user_id": "kept-user", "ring_num": 3},
+	}
+	for i := 0; i < 250; i++ {
+		ringNum := 1
+		if i == 0 {
+			ringNum = 2
+		}
+		members = append(members, map[string]interface{}{"user_id": fmt.Sprintf("user-%03d", i), "ring_num": ringNum})
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceRoutingQueue().Schema, map[string]interface{}{
+		"name":    "Unit Test Queue",
+		"members": members,
+	})
+	d.SetId("queue-id")
+
+	if diagErr := updateQueueMembers(d, proxy); diagErr.HasError() {
+		t.Fatalf("Failed to update queue members: %v", diagErr)
+	}
+
+	// Members are removed and then added in chunks of 100
+	if fmt.Sprint(proxy.memberUpdates) != "[1 100 100 50]" {
+		t.Errorf("Expected member updates of [1 100 100 50], got %v", proxy.memberUpdates)
+	}
+	if _, found := proxy.ringNums["removed-user"]; found {
+		t.Error("Expected removed-user to be removed from the queue")
+	}
+	if proxy.ringNums["kept-user"] != 3 || proxy.ringNums["user-000"] != 2 || proxy.ringNums["user-001"] != 1 {
+		t.Errorf("Unexpected ring numbers: kept-user=%d user-000=%d user-001=%d", proxy.ringNums["kept-user"], proxy.ringNums["user-000"], proxy.ringNums["user-001"])
+	}
+
+	memberSet, diagErr := flattenQueueMembers(d.Id(), proxy)
+	if diagErr.HasError() {
+		t.Fatalf("Failed to flatten queue members: %v", diagErr)
+	}
+	if memberSet.Len() != 251 {
+		t.Errorf("Expected 251 flattened members across pages, got %d", memberSet.Len())
+	}
+}
+
+func TestUnitQueueMemberGroups(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	ctx := context.Background()
+	queueResource := resourceRoutingQueue()
+
+	d := schema.TestResourceDataRaw(t, queueResource.Schema, map[string]interface{}{
+		"name": "Unit Test Queue",
+		"member_groups": []interface{}{
+			map[string]interface{}{"group_id": "group-id"},
+			map[string]interface{}{"group_id": "team-id", "type": "TEAM"},
+		},
+		"skill_groups": []interface{}{"skill-group-id"},
+	})
+	if diagErr := queueResource.CreateContext(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to create queue: %v", diagErr)
+	}
+
+	memberGroups, _ := fakeAPI.get("/api/v2/routing/queues", d.Id())["memberGroups"].([]interface{})
+	if len(memberGroups) != 3 {
+		t.Fatalf("Expected 3 member groups to be set on the queue, got %v", memberGroups)
+	}
+
+	// Users added through groups are not direct members
+	fakeAPI.subResources["/api/v2/routing/queues/"+d.Id()+"/members"] = map[string]interface{}{
+		"entities": []interface{}{
+			map[string]interface{}{"id": "direct-user", "ringNumber": 1, "memberBy": "user"},
+			map[string]interface{}{"id": "group-user", "ringNumber": 1, "memberBy": "group"},
+		},
+	}
+	if diagErr := queueResource.ReadContext(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to read queue: %v", diagErr)
+	}
+
+	groups := d.Get("member_groups").(*schema.Set)
+	if groups.Len() != 2 || !groups.Contains(map[string]interface{}{"group_id": "team-id", "type": "TEAM"}) {
+		t.Errorf("Unexpected member groups: %v", groups.List())
+	}
+	if skillGroups := d.Get("skill_groups").(*schema.Set); skillGroups.Len() != 1 || !skillGroups.Contains("skill-group-id") {
+		t.Errorf("Unexpected skill groups: %v", skillGroups.List())
+	}
+	members := d.Get("members").(*schema.Set).List()
+	if len(members) != 1 || members[0].(map[string]interface{})["user_id"] != "direct-user" {
+		t.Errorf("Expected only direct-user in members, got %v", members)
+	}
+}
+
+func TestUnitQueueExtensions(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	ctx := context.Background()
+	queueResource := resourceRoutingQueue()
+
+	backupQueue := fakeAPI.create("/api/v2/routing/queues", map[string]interface{}{"name": "Backup Queue"})
+	d := schema.TestResourceDataRaw(t, queueResource.Schema, map[string]interface{}{
+		"name": "Unit Test Queue",
+		"media_settings_callback": []interface{}{
+			map[string]interface{}{
+				"alerting_timeout_sec":      30,
+				"service_level_percentage":  0.8,
+				"service_level_duration_ms": 20000,
+				"mode":                      "CustomerFirst",
+				"enable_auto_dial_and_end":  true,
+				"auto_end_delay_seconds":    10,
+			},
+		},
+		"agent_owned_routing": []interface{}{
+			map[string]interface{}{"enable_agent_owned_callbacks": true, "max_owned_callback_hours": 2},
+		},
+		"direct_routing": []interface{}{
+			map[string]interface{}{"backup_queue_id": backupQueue["id"], "wait_for_agent": true},
+		},
+	})
+	if diagErr := queueResource.CreateContext(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to create queue: %v", diagErr)
+	}
+
+	// Settings not in the SDK are merged with the SDK media settings
+	queue := fakeAPI.get("/api/v2/routing/queues", d.Id())
+	callbackSettings, _ := queue["mediaSettings"].(map[string]interface{})["callback"].(map[string]interface{})
+	if callbackSettings["mode"] != "CustomerFirst" || callbackSettings["alertingTimeoutSeconds"] != 30.0 {
+		t.Errorf("Unexpected callback media settings: %v", callbackSettings)
+	}
+	if _, found := callbackSettings["autoDialDelaySeconds"]; found {
+		t.Errorf("Expected unset auto_dial_delay_seconds not to be sent, got %v", callbackSettings)
+	}
+
+	if d.Get("media_settings_callback.0.mode") != "CustomerFirst" || d.Get("media_settings_callback.0.auto_end_delay_seconds") != 10 {
+		t.Errorf("Unexpected callback media settings read from the API: %v", d.Get("media_settings_callback"))
+	}
+	if d.Get("agent_owned_routing.0.enable_agent_owned_callbacks") != true || d.Get("agent_owned_routing.0.max_owned_callback_hours") != 2 {
+		t.Errorf("Unexpected agent owned routing read from the API: %v", d.Get("agent_owned_routing"))
+	}
+	if d.Get("direct_routing.0.backup_queue_id") != backupQueue["id"] || d.Get("direct_routing.0.agent_wait_seconds") != 60 || d.Get("direct_routing.0.call_use_agent_address_outbound") != true {
+		t.Errorf("Unexpected direct routing read from the API: %v", d.Get("direct_routing"))
+	}
+}
+
 func testVerifyQueuesDestroyed(state *terraform.State) error {
 	routingAPI := platformclientv2.NewRoutingApi()
 	for _, rs := range state.RootModule().Resources {
@@ -382,6 +697,13 @@ func generateRoutingQueueResourceBasic(resourceID string, name string, nestedBlo
 	`, resourceID, name, strings.Join(nestedBlocks, "\n"))
 }
 
+func generateQueueMemberGroup(groupID string) string {
+	return fmt.Sprintf(`member_groups {
+		group_id = %s
+	}
+	`, groupID)
+}
+
 func generateRoutingQueueResource(
 	resourceID string,
 	name string,
@@ -422,13 +744,32 @@ func generateRoutingQueueResource(
 		strings.Join(nestedBlocks, "\n"))
 }
 
-func generateMediaSettings(attrName string, alertingTimeout string, slPercent string, slDurationMs string) string {
+func generateMediaSettings(attrName string, alertingTimeout string, slPercent string, slDurationMs string, extraAttrs ...string) string {
 	return fmt.Sprintf(`%s {
 		alerting_timeout_sec = %s
 		service_level_percentage = %s
 		service_level_duration_ms = %s
+		%s
+	}
+	`, attrName, alertingTimeout, slPercent, slDurationMs, strings.Join(extraAttrs, "\n"))
+}
+
+func generateAgentOwnedRouting(enableCallbacks string, maxHours string, maxDelayHours string) string {
+	return fmt.Sprintf(`agent_owned_routing {
+		enable_agent_owned_callbacks = %s
+		max_owned_callback_hours = %s
+		max_owned_callback_delay_hours = %s
+	}
+	`, enableCallbacks, maxHours, maxDelayHours)
+}
+
+func generateDirectRouting(backupQueueID string, waitForAgent string, agentWaitSeconds string) string {
+	return fmt.Sprintf(`direct_routing {
+		backup_queue_id = %s
+		wait_for_agent = %s
+		agent_wait_seconds = %s
 	}
-	`, attrName, alertingTimeout, slPercent, slDurationMs)
+	`, backupQueueID, waitForAgent, agentWaitSeconds)
 }
 
 func generateRoutingRules(operator string, threshold string, waitSeconds string) string {
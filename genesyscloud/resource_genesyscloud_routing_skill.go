@@ -51,9 +51,7 @@ func resourceRoutingSkill() *schema.Resource {
 		CreateContext: createWithPooledClient(createRoutingSkill),
 		ReadContext:   readWithPooledClient(readRoutingSkill),
 		DeleteContext: deleteWithPooledClient(deleteRoutingSkill),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceRoutingSkill, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -69,14 +67,18 @@ func resourceRoutingSkill() *schema.Resource {
 func createRoutingSkill(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Creating skill %s", name)
-	skill, _, err := routingAPI.PostRoutingSkills(platformclientv2.Routingskill{
+	skill, resp, err := routingAPI.PostRoutingSkills(platformclientv2.Routingskill{
 		Name: &name,
 	})
 	if err != nil {
+		if existingID, ok := getAdoptableObjectID(ctx, meta, resp, dataSourceRoutingSkill, name); ok {
+			log.Printf("Adopting existing skill %s %s", name, existingID)
+			d.SetId(existingID)
+			return readRoutingSkill(ctx, d, meta)
+		}
 		return diag.Errorf("Failed to create skill %s: %s", name, err)
 	}
 
@@ -87,8 +89,7 @@ func createRoutingSkill(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func readRoutingSkill(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Reading skill %s", d.Id())
 	return withRetriesForRead(ctx, 30*time.Second, d, func() *resource.RetryError {
@@ -114,8 +115,7 @@ func readRoutingSkill(ctx context.Context, d *schema.ResourceData, meta interfac
 func deleteRoutingSkill(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Deleting skill %s", name)
 	_, err := routingAPI.DeleteRoutingSkill(d.Id())
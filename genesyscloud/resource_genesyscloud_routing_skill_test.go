@@ -1,11 +1,14 @@
 package genesyscloud
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
 )
@@ -41,6 +44,126 @@ func TestAccResourceRoutingSkillBasic(t *testing.T) {
 	})
 }
 
+func TestUnitResourceRoutingSkill(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	ctx := context.Background()
+	skillResource := resourceRoutingSkill()
+
+	d := schema.TestResourceDataRaw(t, skillResource.Schema, map[string]interface{}{"name": "Unit Test Skill"})
+	if diagErr := skillResource.CreateContext(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to create skill: %v", diagErr)
+	}
+	if fakeAPI.get("/api/v2/routing/skills", d.Id()) == nil {
+		t.Fatalf("Skill %s was not created in the fake API", d.Id())
+	}
+
+	// Skill names must be unique
+	duplicate := schema.TestResourceDataRaw(t, skillResource.Schema, map[string]interface{}{"name": "Unit Test Skill"})
+	if diagErr := skillResource.CreateContext(ctx, duplicate, meta); !diagErr.HasError() {
+		t.Fatal("Expected an error creating a duplicate skill")
+	}
+
+	if diagErr := skillResource.DeleteContext(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to delete skill: %v", diagErr)
+	}
+
+	// Reading a deleted skill removes it from state
+	if diagErr := skillResource.ReadContext(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to read deleted skill: %v", diagErr)
+	}
+	if d.Id() != "" {
+		t.Fatalf("Expected deleted skill to be removed from state, got ID %s", d.Id())
+	}
+}
+
+func TestUnitResourceRoutingSkillReadOnly(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	readOnlyMeta := *meta
+	readOnlyMeta.ReadOnly = true
+	ctx := context.Background()
+	skillResource := resourceRoutingSkill()
+
+	d := schema.TestResourceDataRaw(t, skillResource.Schema, map[string]interface{}{"name": "Read Only Skill"})
+	if diagErr := skillResource.CreateContext(ctx, d, &readOnlyMeta); !diagErr.HasError() {
+		t.Fatal("Expected an error creating a skill with a read-only provider")
+	}
+	if names := fakeAPI.sortedNames("/api/v2/routing/skills"); len(names) != 0 {
+		t.Fatalf("Expected no skills to be created, got %v", names)
+	}
+
+	skill := fakeAPI.create("/api/v2/routing/skills", map[string]interface{}{"name": "Read Only Skill"})
+	d.SetId(skill["id"].(string))
+	if diagErr := skillResource.ReadContext(ctx, d, &readOnlyMeta); diagErr.HasError() || d.Id() == "" {
+		t.Fatalf("Expected skill to be readable with a read-only provider: %v", diagErr)
+	}
+	if diagErr := skillResource.DeleteContext(ctx, d, &readOnlyMeta); !diagErr.HasError() {
+		t.Fatal("Expected an error deleting a skill with a read-only provider")
+	}
+	if fakeAPI.get("/api/v2/routing/skills", d.Id())["state"] != "active" {
+		t.Fatal("Expected skill not to be deleted by a read-only provider")
+	}
+}
+
+func TestUnitResourceRoutingSkillAdoptExisting(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	adoptMeta := *meta
+	adoptMeta.AdoptExisting = true
+	ctx := context.Background()
+	skillResource := resourceRoutingSkill()
+
+	existing := fakeAPI.create("/api/v2/routing/skills", map[string]interface{}{"name": "Existing Skill"})
+
+	d := schema.TestResourceDataRaw(t, skillResource.Schema, map[string]interface{}{"name": "Existing Skill"})
+	if diagErr := skillResource.CreateContext(ctx, d, meta); !diagErr.HasError() {
+		t.Fatal("Expected an error creating a duplicate skill without adopt_existing")
+	}
+
+	d = schema.TestResourceDataRaw(t, skillResource.Schema, map[string]interface{}{"name": "Existing Skill"})
+	if diagErr := skillResource.CreateContext(ctx, d, &adoptMeta); diagErr.HasError() {
+		t.Fatalf("Failed to adopt existing skill: %v", diagErr)
+	}
+	if d.Id() != existing["id"] {
+		t.Errorf("Expected existing skill %v to be adopted, got %s", existing["id"], d.Id())
+	}
+	if names := fakeAPI.sortedNames("/api/v2/routing/skills"); len(names) != 1 {
+		t.Errorf("Expected no new skills to be created, got %v", names)
+	}
+}
+
+// fakeDeletingSkillProxy is an in-memory routing proxy for a skill that takes time to be deleted
+type fakeDeletingSkillProxy struct {
+	routingProxy
+
+	getsUntilDeleted int
+}
+
+func (p *fakeDeletingSkillProxy) DeleteRoutingSkill(skillID string) (*platformclientv2.APIResponse, error) {
+	return nil, nil
+}
+
+func (p *fakeDeletingSkillProxy) GetRoutingSkill(skillID string) (*platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
+	if p.getsUntilDeleted > 0 {
+		p.getsUntilDeleted--
+		state := "active"
+		return &platformclientv2.Routingskill{Id: &skillID, State: &state}, nil, nil
+	}
+	return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("skill %s not found", skillID)
+}
+
+func TestUnitDeleteRoutingSkillRetries(t *testing.T) {
+	proxy := &fakeDeletingSkillProxy{getsUntilDeleted: 2}
+	meta := &providerMeta{proxies: &apiProxies{routing: proxy}}
+
+	d := schema.TestResourceDataRaw(t, resourceRoutingSkill().Schema, map[string]interface{}{"name": "Unit Test Skill"})
+	d.SetId("skill-id")
+	if diagErr := deleteRoutingSkill(context.Background(), d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to delete skill: %v", diagErr)
+	}
+	if proxy.getsUntilDeleted != 0 {
+		t.Errorf("Expected delete to poll until the skill was deleted, %d reads remaining", proxy.getsUntilDeleted)
+	}
+}
+
 func generateRoutingSkillResource(
 	resourceID string,
 	name string) string {
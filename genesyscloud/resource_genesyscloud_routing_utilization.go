@@ -47,6 +47,28 @@ var (
 			},
 		},
 	}
+
+	labelUtilizationResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"label_id": {
+				Description: "ID of the utilization label.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"maximum_capacity": {
+				Description:  "Maximum capacity of conversations with this label. Value must be between 0 and 25.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 25),
+			},
+			"interrupting_label_ids": {
+				Description: "Set of IDs of other labels that can interrupt conversations with this label.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
 )
 
 func getSdkUtilizationTypes() []string {
@@ -68,7 +90,10 @@ func getAllRoutingUtilization(_ context.Context, _ *platformclientv2.Configurati
 func routingUtilizationExporter() *ResourceExporter {
 	return &ResourceExporter{
 		GetResourcesFunc: getAllWithPooledClient(getAllRoutingUtilization),
-		RefAttrs:         map[string]*RefAttrSettings{}, // No references
+		RefAttrs: map[string]*RefAttrSettings{
+			"label_utilizations.label_id":               {RefType: "genesyscloud_routing_utilization_label"},
+			"label_utilizations.interrupting_label_ids": {RefType: "genesyscloud_routing_utilization_label"},
+		},
 	}
 }
 
@@ -133,6 +158,13 @@ func resourceRoutingUtilization() *schema.Resource {
 				Computed:    true,
 				Elem:        utilizationSettingsResource,
 			},
+			"label_utilizations": {
+				Description: "Label utilization settings. Conversations with a label use the label's capacity instead of their media type's capacity. If not set, this resource will not manage label utilizations.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        labelUtilizationResource,
+			},
 		},
 	}
 }
@@ -144,12 +176,11 @@ func createRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func readRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Reading Routing Utilization")
 	return withRetriesForRead(ctx, 30*time.Second, d, func() *resource.RetryError {
-		settings, resp, getErr := routingAPI.GetRoutingUtilization()
+		settings, resp, getErr := routingAPI.GetRoutingUtilizationWithLabels()
 		if getErr != nil {
 			if isStatus404(resp) {
 				return resource.RetryableError(fmt.Errorf("Failed to read Routing Utilization: %s", getErr))
@@ -166,6 +197,7 @@ func readRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta in
 				}
 			}
 		}
+		d.Set("label_utilizations", flattenLabelUtilizations(settings.LabelUtilizations))
 
 		log.Printf("Read Routing Utilization")
 		return nil
@@ -173,16 +205,16 @@ func readRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func updateRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Reset first to attempt to avoid caching issue")
 	deleteRoutingUtilization(ctx, d, meta)
 
 	log.Printf("Updating Routing Utilization")
 
-	_, _, err := routingAPI.PutRoutingUtilization(platformclientv2.Utilization{
-		Utilization: buildSdkRoutingUtilizations(d),
+	_, err := routingAPI.PutRoutingUtilizationWithLabels(labelledUtilization{
+		Utilization:       buildSdkRoutingUtilizations(d),
+		LabelUtilizations: buildLabelUtilizations(d.Get("label_utilizations").(*schema.Set)),
 	})
 	if err != nil {
 		return diag.Errorf("Failed to update Routing Utilization: %s", err)
@@ -253,3 +285,47 @@ func buildSdkMediaUtilization(settings []interface{}) platformclientv2.Mediautil
 		InterruptableMediaTypes: interruptableMediaTypes,
 	}
 }
+
+func buildLabelUtilizations(labelSettings *schema.Set) *map[string]labelUtilization {
+	settings := make(map[string]labelUtilization)
+	if labelSettings == nil {
+		return &settings
+	}
+
+	for _, labelConfig := range labelSettings.List() {
+		labelMap := labelConfig.(map[string]interface{})
+		maxCapacity := labelMap["maximum_capacity"].(int)
+
+		interruptingLabelIDs := &[]string{}
+		if ids, ok := labelMap["interrupting_label_ids"]; ok {
+			interruptingLabelIDs = setToStringList(ids.(*schema.Set))
+		}
+
+		settings[labelMap["label_id"].(string)] = labelUtilization{
+			MaximumCapacity:      &maxCapacity,
+			InterruptingLabelIds: interruptingLabelIDs,
+		}
+	}
+	return &settings
+}
+
+func flattenLabelUtilizations(labelUtilizations *map[string]labelUtilization) *schema.Set {
+	labelSet := schema.NewSet(schema.HashResource(labelUtilizationResource), []interface{}{})
+	if labelUtilizations == nil {
+		return labelSet
+	}
+
+	for labelID, settings := range *labelUtilizations {
+		labelMap := map[string]interface{}{
+			"label_id": labelID,
+		}
+		if settings.MaximumCapacity != nil {
+			labelMap["maximum_capacity"] = *settings.MaximumCapacity
+		}
+		if settings.InterruptingLabelIds != nil {
+			labelMap["interrupting_label_ids"] = stringListToSet(*settings.InterruptingLabelIds)
+		}
+		labelSet.Add(labelMap)
+	}
+	return labelSet
+}
@@ -0,0 +1,160 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+func getAllRoutingUtilizationLabels(_ context.Context, clientConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(ResourceIDMetaMap)
+	routingAPI := &sdkRoutingProxy{platformclientv2.NewRoutingApiWithConfig(clientConfig)}
+
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		labels, _, getErr := routingAPI.GetRoutingUtilizationLabels(pageSize, pageNum, "")
+		if getErr != nil {
+			return nil, diag.Errorf("Failed to get page of utilization labels: %v", getErr)
+		}
+
+		if labels.Entities == nil || len(*labels.Entities) == 0 {
+			break
+		}
+
+		for _, label := range *labels.Entities {
+			resources[*label.Id] = &ResourceMeta{Name: *label.Name}
+		}
+	}
+
+	return resources, nil
+}
+
+func routingUtilizationLabelExporter() *ResourceExporter {
+	return &ResourceExporter{
+		GetResourcesFunc: getAllWithPooledClient(getAllRoutingUtilizationLabels),
+		RefAttrs:         map[string]*RefAttrSettings{}, // No references
+	}
+}
+
+func resourceRoutingUtilizationLabel() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Routing Utilization Label. Labels set the capacity of conversations they are assigned to in routing utilization settings.",
+
+		CreateContext: createWithPooledClient(createRoutingUtilizationLabel),
+		ReadContext:   readWithPooledClient(readRoutingUtilizationLabel),
+		UpdateContext: updateWithPooledClient(updateRoutingUtilizationLabel),
+		DeleteContext: deleteWithPooledClient(deleteRoutingUtilizationLabel),
+		Importer:      importByName(dataSourceRoutingUtilizationLabel, "name"),
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Label name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func createRoutingUtilizationLabel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	routingAPI := meta.(*providerMeta).routingProxy()
+
+	log.Printf("Creating utilization label %s", name)
+	label, resp, err := routingAPI.PostRoutingUtilizationLabels(utilizationLabel{
+		Name: &name,
+	})
+	if err != nil {
+		if existingID, ok := getAdoptableObjectID(ctx, meta, resp, dataSourceRoutingUtilizationLabel, name); ok {
+			log.Printf("Adopting existing utilization label %s %s", name, existingID)
+			d.SetId(existingID)
+			return readRoutingUtilizationLabel(ctx, d, meta)
+		}
+		return diag.Errorf("Failed to create utilization label %s: %s", name, err)
+	}
+
+	d.SetId(*label.Id)
+
+	log.Printf("Created utilization label %s %s", name, *label.Id)
+	return readRoutingUtilizationLabel(ctx, d, meta)
+}
+
+func readRoutingUtilizationLabel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routingAPI := meta.(*providerMeta).routingProxy()
+
+	log.Printf("Reading utilization label %s", d.Id())
+	return withRetriesForRead(ctx, 30*time.Second, d, func() *resource.RetryError {
+		label, resp, getErr := routingAPI.GetRoutingUtilizationLabel(d.Id())
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to read utilization label %s: %s", d.Id(), getErr))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to read utilization label %s: %s", d.Id(), getErr))
+		}
+
+		d.Set("name", *label.Name)
+		log.Printf("Read utilization label %s %s", d.Id(), *label.Name)
+		return nil
+	})
+}
+
+func updateRoutingUtilizationLabel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	routingAPI := meta.(*providerMeta).routingProxy()
+
+	log.Printf("Updating utilization label %s", name)
+	diagErr := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		// Get current label version
+		label, resp, getErr := routingAPI.GetRoutingUtilizationLabel(d.Id())
+		if getErr != nil {
+			return resp, diag.Errorf("Failed to read utilization label %s: %s", d.Id(), getErr)
+		}
+
+		_, resp, putErr := routingAPI.PutRoutingUtilizationLabel(d.Id(), utilizationLabel{
+			Name:    &name,
+			Version: label.Version,
+		})
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update utilization label %s: %s", name, putErr)
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated utilization label %s", name)
+	return readRoutingUtilizationLabel(ctx, d, meta)
+}
+
+func deleteRoutingUtilizationLabel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	routingAPI := meta.(*providerMeta).routingProxy()
+
+	log.Printf("Deleting utilization label %s", name)
+	_, err := routingAPI.DeleteRoutingUtilizationLabel(d.Id(), false)
+	if err != nil {
+		return diag.Errorf("Failed to delete utilization label %s: %s", name, err)
+	}
+
+	return withRetries(ctx, 30*time.Second, func() *resource.RetryError {
+		_, resp, err := routingAPI.GetRoutingUtilizationLabel(d.Id())
+		if err != nil {
+			if isStatus404(resp) {
+				log.Printf("Deleted utilization label %s", d.Id())
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error deleting utilization label %s: %s", d.Id(), err))
+		}
+		return resource.RetryableError(fmt.Errorf("Utilization label %s still exists", d.Id()))
+	})
+}
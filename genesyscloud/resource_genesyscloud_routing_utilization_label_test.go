@@ -0,0 +1,289 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+func TestAccResourceRoutingUtilizationLabel(t *testing.T) {
+	var (
+		labelResource = "test-label"
+		labelName1    = "Terraform Label " + uuid.NewString()
+		labelName2    = "Terraform Label " + uuid.NewString()
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: generateRoutingUtilizationLabelResource(labelResource, labelName1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_utilization_label."+labelResource, "name", labelName1),
+				),
+			},
+			{
+				// Update
+				Config: generateRoutingUtilizationLabelResource(labelResource, labelName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_utilization_label."+labelResource, "name", labelName2),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_routing_utilization_label." + labelResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyRoutingUtilizationLabelsDestroyed,
+	})
+}
+
+// fakeUtilizationLabelsProxy is an in-memory routing proxy for utilization labels and label utilization settings
+type fakeUtilizationLabelsProxy struct {
+	routingProxy
+
+	labels          map[string]*utilizationLabel
+	orgUtilization  labelledUtilization
+	userUtilization map[string]labelledUtilization
+}
+
+func newFakeUtilizationLabelsProxy() *fakeUtilizationLabelsProxy {
+	return &fakeUtilizationLabelsProxy{
+		labels:          make(map[string]*utilizationLabel),
+		userUtilization: make(map[string]labelledUtilization),
+	}
+}
+
+func (p *fakeUtilizationLabelsProxy) GetRoutingUtilizationLabel(labelID string) (*utilizationLabel, *platformclientv2.APIResponse, error) {
+	label, ok := p.labels[labelID]
+	if !ok {
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("label %s not found", labelID)
+	}
+	return label, nil, nil
+}
+
+func (p *fakeUtilizationLabelsProxy) PostRoutingUtilizationLabels(body utilizationLabel) (*utilizationLabel, *platformclientv2.APIResponse, error) {
+	id := uuid.NewString()
+	version := 1
+	p.labels[id] = &utilizationLabel{Id: &id, Name: body.Name, Version: &version}
+	return p.labels[id], nil, nil
+}
+
+func (p *fakeUtilizationLabelsProxy) PutRoutingUtilizationLabel(labelID string, body utilizationLabel) (*utilizationLabel, *platformclientv2.APIResponse, error) {
+	label := p.labels[labelID]
+	if *body.Version != *label.Version {
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusConflict}, fmt.Errorf("version mismatch")
+	}
+	version := *label.Version + 1
+	p.labels[labelID] = &utilizationLabel{Id: &labelID, Name: body.Name, Version: &version}
+	return p.labels[labelID], nil, nil
+}
+
+func (p *fakeUtilizationLabelsProxy) DeleteRoutingUtilizationLabel(labelID string, forceDelete bool) (*platformclientv2.APIResponse, error) {
+	delete(p.labels, labelID)
+	return nil, nil
+}
+
+func (p *fakeUtilizationLabelsProxy) GetRoutingUtilizationWithLabels() (*labelledUtilization, *platformclientv2.APIResponse, error) {
+	return &p.orgUtilization, nil, nil
+}
+
+func (p *fakeUtilizationLabelsProxy) PutRoutingUtilizationWithLabels(body labelledUtilization) (*platformclientv2.APIResponse, error) {
+	p.orgUtilization = body
+	return nil, nil
+}
+
+func (p *fakeUtilizationLabelsProxy) GetRoutingUserUtilizationWithLabels(userID string) (*labelledUtilization, *platformclientv2.APIResponse, error) {
+	settings := p.userUtilization[userID]
+	return &settings, nil, nil
+}
+
+func (p *fakeUtilizationLabelsProxy) PutRoutingUserUtilizationWithLabels(userID string, body labelledUtilization) (*platformclientv2.APIResponse, error) {
+	level := "Agent"
+	body.Level = &level
+	p.userUtilization[userID] = body
+	return nil, nil
+}
+
+func TestUnitRoutingUtilizationLabel(t *testing.T) {
+	proxy := newFakeUtilizationLabelsProxy()
+	meta := &providerMeta{proxies: &apiProxies{routing: proxy}}
+	ctx := context.Background()
+	labelResource := resourceRoutingUtilizationLabel()
+
+	d := schema.TestResourceDataRaw(t, labelResource.Schema, map[string]interface{}{"name": "VIP Chat"})
+	if diagErr := createRoutingUtilizationLabel(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to create utilization label: %v", diagErr)
+	}
+	labelID := d.Id()
+
+	d.Set("name", "VIP Chats")
+	if diagErr := updateRoutingUtilizationLabel(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to update utilization label: %v", diagErr)
+	}
+	if *proxy.labels[labelID].Name != "VIP Chats" || *proxy.labels[labelID].Version != 2 {
+		t.Errorf("Expected label to be renamed, got %v", *proxy.labels[labelID].Name)
+	}
+
+	if diagErr := deleteRoutingUtilizationLabel(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to delete utilization label: %v", diagErr)
+	}
+	if len(proxy.labels) != 0 {
+		t.Error("Expected utilization label to be deleted")
+	}
+}
+
+func TestUnitLabelUtilizations(t *testing.T) {
+	proxy := newFakeUtilizationLabelsProxy()
+	meta := &providerMeta{proxies: &apiProxies{routing: proxy}}
+	labelSettings := []interface{}{
+		map[string]interface{}{
+			"label_id":               "vip-label",
+			"maximum_capacity":       1,
+			"interrupting_label_ids": []interface{}{},
+		},
+		map[string]interface{}{
+			"label_id":               "standard-label",
+			"maximum_capacity":       3,
+			"interrupting_label_ids": []interface{}{"vip-label"},
+		},
+	}
+
+	// Org-wide label utilizations
+	utilResource := resourceRoutingUtilization()
+	d := schema.TestResourceDataRaw(t, utilResource.Schema, map[string]interface{}{
+		"label_utilizations": labelSettings,
+	})
+	d.SetId("routing_utilization")
+	_, err := proxy.PutRoutingUtilizationWithLabels(labelledUtilization{
+		Utilization:       buildSdkRoutingUtilizations(d),
+		LabelUtilizations: buildLabelUtilizations(d.Get("label_utilizations").(*schema.Set)),
+	})
+	if err != nil {
+		t.Fatalf("Failed to update org utilization: %v", err)
+	}
+	standard := (*proxy.orgUtilization.LabelUtilizations)["standard-label"]
+	if *standard.MaximumCapacity != 3 || len(*standard.InterruptingLabelIds) != 1 || (*standard.InterruptingLabelIds)[0] != "vip-label" {
+		t.Errorf("Expected standard label to be interrupted by the VIP label, got %v", standard)
+	}
+
+	if diagErr := readRoutingUtilization(context.Background(), d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to read org utilization: %v", diagErr)
+	}
+	if d.Get("label_utilizations").(*schema.Set).Len() != 2 {
+		t.Errorf("Expected 2 label utilizations, got %v", d.Get("label_utilizations"))
+	}
+
+	// Label utilizations are kept when they are not configured
+	orgDiff, err := utilResource.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{}), meta)
+	if err != nil {
+		t.Fatalf("Failed to plan org utilization: %v", err)
+	}
+	if orgDiff != nil {
+		for attr := range orgDiff.Attributes {
+			if strings.HasPrefix(attr, "label_utilizations") {
+				t.Errorf("Expected no changes to label utilizations, got %v", orgDiff.Attributes)
+				break
+			}
+		}
+	}
+
+	// User label utilizations
+	userResource := resourceUser()
+	state := &terraform.InstanceState{ID: "user-id", Attributes: map[string]string{"id": "user-id"}}
+	diff, err := userResource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"email": "user@example.com",
+		"name":  "User",
+		"routing_utilization": []interface{}{map[string]interface{}{
+			"call": []interface{}{map[string]interface{}{
+				"maximum_capacity": 1,
+			}},
+			"label_utilizations": labelSettings,
+		}},
+	}), meta)
+	if err != nil {
+		t.Fatalf("Failed to plan user: %v", err)
+	}
+	d, err = schema.InternalMap(userResource.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("Failed to apply user plan: %v", err)
+	}
+	if diagErr := updateUserRoutingUtilization(d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to update user utilization: %v", diagErr)
+	}
+	if len(*proxy.userUtilization["user-id"].LabelUtilizations) != 2 {
+		t.Errorf("Expected 2 user label utilizations, got %v", proxy.userUtilization["user-id"])
+	}
+
+	if diagErr := readUserRoutingUtilization(d, proxy); diagErr.HasError() {
+		t.Fatalf("Failed to read user utilization: %v", diagErr)
+	}
+	if d.Get("routing_utilization.0.label_utilizations").(*schema.Set).Len() != 2 {
+		t.Errorf("Expected 2 user label utilizations, got %v", d.Get("routing_utilization"))
+	}
+
+	// User label utilizations are kept when other utilization settings change
+	diff, err = userResource.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"email": "user@example.com",
+		"name":  "User",
+		"routing_utilization": []interface{}{map[string]interface{}{
+			"call": []interface{}{map[string]interface{}{
+				"maximum_capacity": 2,
+			}},
+		}},
+	}), meta)
+	if err != nil {
+		t.Fatalf("Failed to plan user: %v", err)
+	}
+	d, err = schema.InternalMap(userResource.Schema).Data(d.State(), diff)
+	if err != nil {
+		t.Fatalf("Failed to apply user plan: %v", err)
+	}
+	if diagErr := updateUserRoutingUtilization(d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to update user utilization: %v", diagErr)
+	}
+	if len(*proxy.userUtilization["user-id"].LabelUtilizations) != 2 {
+		t.Errorf("Expected user label utilizations to be kept, got %v", proxy.userUtilization["user-id"])
+	}
+}
+
+func testVerifyRoutingUtilizationLabelsDestroyed(state *terraform.State) error {
+	routingAPI := &sdkRoutingProxy{platformclientv2.NewRoutingApi()}
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_routing_utilization_label" {
+			continue
+		}
+
+		label, resp, err := routingAPI.GetRoutingUtilizationLabel(rs.Primary.ID)
+		if label != nil {
+			return fmt.Errorf("Utilization label (%s) still exists", rs.Primary.ID)
+		} else if isStatus404(resp) {
+			// Label not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All labels destroyed
+	return nil
+}
+
+func generateRoutingUtilizationLabelResource(resourceID string, name string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_utilization_label" "%s" {
+		name = "%s"
+	}
+	`, resourceID, name)
+}
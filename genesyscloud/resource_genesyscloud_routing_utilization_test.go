@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -93,6 +94,55 @@ func TestAccResourceRoutingUtilization(t *testing.T) {
 	})
 }
 
+func TestAccResourceRoutingUtilizationWithLabels(t *testing.T) {
+	var (
+		maxCapacity1      = "3"
+		maxCapacity2      = "4"
+		vipLabel          = "vip-label"
+		standardLabel     = "standard-label"
+		vipLabelName      = "Terraform VIP " + uuid.NewString()
+		standardLabelName = "Terraform Standard " + uuid.NewString()
+		labelResources    = generateRoutingUtilizationLabelResource(vipLabel, vipLabelName) +
+			generateRoutingUtilizationLabelResource(standardLabel, standardLabelName)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create with a label capacity
+				Config: labelResources + generateRoutingUtilizationResource(
+					generateRoutingUtilMediaType("chat", maxCapacity1, falseValue),
+					generateRoutingUtilLabel("genesyscloud_routing_utilization_label."+vipLabel+".id", maxCapacity1),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_utilization.routing-util", "label_utilizations.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_utilization.routing-util", "label_utilizations.0.maximum_capacity", maxCapacity1),
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_utilization.routing-util", "label_utilizations.0.label_id", "genesyscloud_routing_utilization_label."+vipLabel, "id"),
+				),
+			},
+			{
+				// Add a label that can be interrupted by the VIP label
+				Config: labelResources + generateRoutingUtilizationResource(
+					generateRoutingUtilMediaType("chat", maxCapacity1, falseValue),
+					generateRoutingUtilLabel("genesyscloud_routing_utilization_label."+vipLabel+".id", maxCapacity1),
+					generateRoutingUtilLabel("genesyscloud_routing_utilization_label."+standardLabel+".id", maxCapacity2, "genesyscloud_routing_utilization_label."+vipLabel+".id"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_utilization.routing-util", "label_utilizations.#", "2"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_routing_utilization.routing-util",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func generateRoutingUtilMediaType(
 	mediaType string,
 	maxCapacity string,
@@ -111,4 +161,13 @@ func generateRoutingUtilizationResource(mediaTypes ...string) string {
 		%s
 	}
 	`, strings.Join(mediaTypes, "\n"))
-}
\ No newline at end of file
+}
+
+func generateRoutingUtilLabel(labelID string, maxCapacity string, interruptingLabelIDs ...string) string {
+	return fmt.Sprintf(`label_utilizations {
+		label_id = %s
+		maximum_capacity = %s
+		interrupting_label_ids = [%s]
+	}
+	`, labelID, maxCapacity, strings.Join(interruptingLabelIDs, ","))
+}
@@ -50,9 +50,7 @@ func resourceRoutingWrapupCode() *schema.Resource {
 		ReadContext:   readWithPooledClient(readRoutingWrapupCode),
 		UpdateContext: updateWithPooledClient(updateRoutingWrapupCode),
 		DeleteContext: deleteWithPooledClient(deleteRoutingWrapupCode),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceRoutingWrapupcode, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -67,14 +65,18 @@ func resourceRoutingWrapupCode() *schema.Resource {
 func createRoutingWrapupCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Creating wrapupcode %s", name)
-	wrapupcode, _, err := routingAPI.PostRoutingWrapupcodes(platformclientv2.Wrapupcode{
+	wrapupcode, resp, err := routingAPI.PostRoutingWrapupcodes(platformclientv2.Wrapupcode{
 		Name: &name,
 	})
 	if err != nil {
+		if existingID, ok := getAdoptableObjectID(ctx, meta, resp, dataSourceRoutingWrapupcode, name); ok {
+			log.Printf("Adopting existing wrapupcode %s %s", name, existingID)
+			d.SetId(existingID)
+			return readRoutingWrapupCode(ctx, d, meta)
+		}
 		return diag.Errorf("Failed to create wrapupcode %s: %s", name, err)
 	}
 
@@ -84,8 +86,7 @@ func createRoutingWrapupCode(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func readRoutingWrapupCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Reading wrapupcode %s", d.Id())
 	return withRetriesForRead(ctx, 30*time.Second, d, func() *resource.RetryError {
@@ -107,8 +108,7 @@ func readRoutingWrapupCode(ctx context.Context, d *schema.ResourceData, meta int
 func updateRoutingWrapupCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Updating wrapupcode %s", name)
 	_, _, err := routingAPI.PutRoutingWrapupcode(d.Id(), platformclientv2.Wrapupcode{
@@ -128,8 +128,7 @@ func updateRoutingWrapupCode(ctx context.Context, d *schema.ResourceData, meta i
 func deleteRoutingWrapupCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	routingAPI := meta.(*providerMeta).routingProxy()
 
 	log.Printf("Deleting wrapupcode %s", name)
 	_, err := routingAPI.DeleteRoutingWrapupcode(d.Id())
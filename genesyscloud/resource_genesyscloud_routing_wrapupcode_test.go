@@ -1,11 +1,13 @@
 package genesyscloud
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
 )
@@ -52,6 +54,26 @@ func TestAccResourceRoutingWrapupcode(t *testing.T) {
 	})
 }
 
+func TestUnitResourceRoutingWrapupcode(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	ctx := context.Background()
+	codeResource := resourceRoutingWrapupCode()
+
+	d := schema.TestResourceDataRaw(t, codeResource.Schema, map[string]interface{}{"name": "Unit Test Code"})
+	if diagErr := codeResource.CreateContext(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to create wrapupcode: %v", diagErr)
+	}
+
+	// Changes made outside of Terraform are detected on read
+	fakeAPI.update("/api/v2/routing/wrapupcodes", d.Id(), map[string]interface{}{"name": "Renamed Code"})
+	if diagErr := codeResource.ReadContext(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to read wrapupcode: %v", diagErr)
+	}
+	if name := d.Get("name").(string); name != "Renamed Code" {
+		t.Fatalf("Expected wrapupcode name to be 'Renamed Code', got '%s'", name)
+	}
+}
+
 func generateRoutingWrapupcodeResource(
 	resourceID string,
 	name string) string {
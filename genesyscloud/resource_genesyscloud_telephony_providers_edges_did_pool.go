@@ -99,8 +99,7 @@ func createDidPool(ctx context.Context, d *schema.ResourceData, meta interface{}
 	comments := d.Get("comments").(string)
 	poolProvider := d.Get("pool_provider").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	telephonyApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+	telephonyApi := meta.(*providerMeta).telephonyProxy()
 
 	log.Printf("Creating DID pool %s", startPhoneNumber)
 	didPool, _, err := telephonyApi.PostTelephonyProvidersEdgesDidpools(platformclientv2.Didpool{
@@ -121,8 +120,7 @@ func createDidPool(ctx context.Context, d *schema.ResourceData, meta interface{}
 }
 
 func readDidPool(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	telephonyApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+	telephonyApi := meta.(*providerMeta).telephonyProxy()
 
 	log.Printf("Reading DID pool %s", d.Id())
 	return withRetriesForRead(ctx, 30*time.Second, d, func() *resource.RetryError {
@@ -172,8 +170,7 @@ func updateDidPool(ctx context.Context, d *schema.ResourceData, meta interface{}
 	comments := d.Get("comments").(string)
 	poolProvider := d.Get("pool_provider").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	telephonyApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+	telephonyApi := meta.(*providerMeta).telephonyProxy()
 
 	didPoolBody := platformclientv2.Didpool{
 		StartPhoneNumber: &startPhoneNumber,
@@ -196,8 +193,7 @@ func updateDidPool(ctx context.Context, d *schema.ResourceData, meta interface{}
 func deleteDidPool(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	startPhoneNumber := d.Get("start_phone_number").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	telephonyApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+	telephonyApi := meta.(*providerMeta).telephonyProxy()
 
 	log.Printf("Deleting DID pool with starting number %s", startPhoneNumber)
 	if _, err := telephonyApi.DeleteTelephonyProvidersEdgesDidpool(d.Id()); err != nil {
@@ -18,10 +18,8 @@ func resourceEdgeGroup() *schema.Resource {
 		CreateContext: createWithPooledClient(createEdgeGroup),
 		ReadContext:   readWithPooledClient(readEdgeGroup),
 		UpdateContext: updateWithPooledClient(updateEdgeGroup),
-		DeleteContext: deleteWithPooledClient(deleteEdgeGroup),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("Edge group", deleteEdgeGroup)),
+		Importer:      importByName(dataSourceEdgeGroup, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -58,6 +56,7 @@ func resourceEdgeGroup() *schema.Resource {
 				Required:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
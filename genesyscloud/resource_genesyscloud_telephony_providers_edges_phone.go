@@ -78,9 +78,7 @@ func resourcePhone() *schema.Resource {
 		ReadContext:   readWithPooledClient(readPhone),
 		UpdateContext: updateWithPooledClient(updatePhone),
 		DeleteContext: deleteWithPooledClient(deletePhone),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourcePhone, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -52,6 +52,7 @@ func TestAccResourcePhoneBasic(t *testing.T) {
 		userDepartment = "Development"
 	)
 
+	testAccPreCheck(t)
 	err := authorizeSdk()
 	if err != nil {
 		t.Fatal(err)
@@ -198,6 +199,7 @@ func TestAccResourcePhoneStandalone(t *testing.T) {
 	phoneBaseSettingsRes := "phoneBaseSettings1234"
 	phoneBaseSettingsName := "phoneBaseSettings " + uuid.NewString()
 
+	testAccPreCheck(t)
 	err := authorizeSdk()
 	if err != nil {
 		t.Fatal(err)
@@ -317,7 +319,7 @@ func authorizeSdk() error {
 	// Create new config
 	sdkConfig = platformclientv2.GetDefaultConfiguration()
 
-	sdkConfig.BasePath = getRegionBasePath(os.Getenv("GENESYSCLOUD_REGION"))
+	sdkConfig.BasePath = getAPIBasePath(os.Getenv("GENESYSCLOUD_REGION"))
 
 	err := sdkConfig.AuthorizeClientCredentials(os.Getenv("GENESYSCLOUD_OAUTHCLIENT_ID"), os.Getenv("GENESYSCLOUD_OAUTHCLIENT_SECRET"))
 	if err != nil {
@@ -19,9 +19,7 @@ func resourcePhoneBaseSettings() *schema.Resource {
 		ReadContext:   readWithPooledClient(readPhoneBaseSettings),
 		UpdateContext: updateWithPooledClient(updatePhoneBaseSettings),
 		DeleteContext: deleteWithPooledClient(deletePhoneBaseSettings),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourcePhoneBaseSettings, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -20,10 +20,8 @@ func resourceSite() *schema.Resource {
 		CreateContext: createWithPooledClient(createSite),
 		ReadContext:   readWithPooledClient(readSite),
 		UpdateContext: updateWithPooledClient(updateSite),
-		DeleteContext: deleteWithPooledClient(deleteSite),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("Site", deleteSite)),
+		Importer:      importByName(dataSourceSite, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -199,6 +197,7 @@ func resourceSite() *schema.Resource {
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -34,6 +34,7 @@ func TestAccResourceSite(t *testing.T) {
 		locationRes = "test-location1"
 	)
 
+	testAccPreCheck(t)
 	err := authorizeSdk()
 	if err != nil {
 		t.Fatal(err)
@@ -157,6 +158,7 @@ func TestAccResourceSiteNumberPlans(t *testing.T) {
 		locationRes = "test-location1"
 	)
 
+	testAccPreCheck(t)
 	err := authorizeSdk()
 	if err != nil {
 		t.Fatal(err)
@@ -348,6 +350,7 @@ func TestAccResourceSiteOutboundRoutes(t *testing.T) {
 		locationRes = "test-location1"
 	)
 
+	testAccPreCheck(t)
 	err := authorizeSdk()
 	if err != nil {
 		t.Fatal(err)
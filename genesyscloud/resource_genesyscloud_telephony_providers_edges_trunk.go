@@ -18,10 +18,8 @@ func resourceTrunk() *schema.Resource {
 		CreateContext: createWithPooledClient(createTrunk),
 		ReadContext:   readWithPooledClient(readTrunk),
 		UpdateContext: updateWithPooledClient(updateTrunk),
-		DeleteContext: deleteWithPooledClient(deleteTrunk),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("Trunk", deleteTrunk)),
+		Importer:      importByName(dataSourceTrunk, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"trunk_base_settings_id": {
@@ -45,6 +43,7 @@ func resourceTrunk() *schema.Resource {
 				Optional:    true,
 				Computed:	true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -20,9 +20,7 @@ func resourceTrunkBaseSettings() *schema.Resource {
 		ReadContext:   readWithPooledClient(readTrunkBaseSettings),
 		UpdateContext: updateWithPooledClient(updateTrunkBaseSettings),
 		DeleteContext: deleteWithPooledClient(deleteTrunkBaseSettings),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByName(dataSourceTrunkBaseSettings, "name"),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -4,13 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/google/uuid"
-	"hash/fnv"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -18,12 +18,14 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const (
-	defaultTfJSONFile  = "genesyscloud.tf.json"
-	defaultTfStateFile = "terraform.tfstate"
+	defaultTfJSONFile     = "genesyscloud.tf.json"
+	defaultTfStateFile    = "terraform.tfstate"
+	defaultTfManifestFile = "export_manifest.json"
 )
 
 func validateSubStringInSlice(valid []string) schema.SchemaValidateFunc {
@@ -59,7 +61,8 @@ func resourceTfExport() *schema.Resource {
 		Description: fmt.Sprintf(`
 		Genesys Cloud Resource to export Terraform config and (optionally) tfstate files to a local directory. 
 		The config file is named '%s', and the state file is named '%s'.
-		`, defaultTfJSONFile, defaultTfStateFile),
+		A manifest mapping each exported object ID to its resource address is written to '%s'.
+		`, defaultTfJSONFile, defaultTfStateFile, defaultTfManifestFile),
 
 		CreateContext: createTfExport,
 		ReadContext:   readTfExport,
@@ -99,15 +102,37 @@ func resourceTfExport() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				ForceNew:    true,
 			},
+			"verify": {
+				Description: "Verify the exported config would apply without changes by comparing it with the current state of each exported resource. Attributes that would change are reported as warnings and in `verify_differences`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"verify_differences": {
+				Description: "Attributes in the exported config that differ from the current state of the exported resources. This is only set when `verify` is true.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"max_concurrency": {
+				Description:  "Maximum number of resources to read concurrently across all exported types. Defaults to the provider's `token_pool_size`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
 		},
 	}
 }
 
 type resourceInfo struct {
 	State   *terraform.InstanceState
+	ID      string
 	Name    string
 	Type    string
 	CtyType cty.Type
+	Files   []string
 }
 
 func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -147,40 +172,107 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	includeStateFile := d.Get("include_state_file").(bool)
+	verify := d.Get("verify").(bool)
 	provider := New(version)()
 
-	// Read the instance data from each exporter
-	var resources []resourceInfo
 	for resType, exporter := range exporters {
-		typeResources, err := getResourcesForType(resType, provider, exporter, meta)
-		if err != nil {
-			return err
+		if resource := provider.ResourcesMap[resType]; resource != nil {
+			exporter.ResourceSchema = resource.Schema
 		}
-		resources = append(resources, typeResources...)
 	}
 
-	// Generate the JSON config map
+	concurrency := sdkClientPool.size()
+	if maxConcurrency, ok := d.GetOk("max_concurrency"); ok {
+		concurrency = maxConcurrency.(int)
+	}
+
+	// Config for each resource type is written to a temporary file as soon as every resource of that type has been read,
+	// so only the types still being read are held in memory. Config is sanitized once all reads are complete, as references
+	// can only be resolved after any missing resources have been removed from the sanitized resource maps.
+	partialDir, err := ioutil.TempDir("", "genesyscloud-export")
+	if err != nil {
+		return diag.Errorf("Failed to create temporary export directory: %v", err)
+	}
+	defer os.RemoveAll(partialDir)
+
+	var resources []resourceInfo
+	var exportedTypes []string
 	resourceTypeJSONMaps := make(map[string]map[string]jsonMap)
+	manifest := make(map[string]*exportManifestEntry)
+	diagErr = readExportResources(ctx, provider, exporters, meta, concurrency, d.Get("directory").(string), func(resource resourceInfo, jsonResult jsonMap) diag.Diagnostics {
+		if resourceTypeJSONMaps[resource.Type] == nil {
+			resourceTypeJSONMaps[resource.Type] = make(map[string]jsonMap)
+		}
+
+		resourceTypeJSONMaps[resource.Type][resource.Name] = jsonResult
+		manifest[resource.Type+"."+resource.Name] = newExportManifestEntry(resource, exporters[resource.Type], jsonResult)
+
+		if includeStateFile || verify {
+			resources = append(resources, resource)
+		}
+		return nil
+	}, func(resType string) diag.Diagnostics {
+		if len(resourceTypeJSONMaps[resType]) == 0 {
+			return nil
+		}
+		if err := writePartialConfig(partialDir, resType, resourceTypeJSONMaps[resType]); err != nil {
+			return err
+		}
+		delete(resourceTypeJSONMaps, resType)
+		exportedTypes = append(exportedTypes, resType)
+		return nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+	sort.Strings(exportedTypes)
+
+	resourcesByType := make(map[string][]resourceInfo)
 	for _, resource := range resources {
-		jsonResult, diagErr := instanceStateToJSONMap(resource.State, resource.CtyType)
+		resourcesByType[resource.Type] = append(resourcesByType[resource.Type], resource)
+	}
+
+	configWriter, diagErr := newExportConfigWriter(filePath)
+	if diagErr != nil {
+		return diagErr
+	}
+	defer configWriter.file.Close()
+
+	differences := []string{}
+	for _, resType := range exportedTypes {
+		resourceJSONMaps, diagErr := readPartialConfig(partialDir, resType)
 		if diagErr != nil {
 			return diagErr
 		}
+		for resName, jsonResult := range resourceJSONMaps {
+			// Removes zero values and sets proper reference expressions
+			sanitizeConfigMap(resType, jsonResult, "", exporters, includeStateFile, manifest[resType+"."+resName])
+		}
 
-		// Removes zero values and sets proper reference expressions
-		sanitizeConfigMap(resource.Type, jsonResult, "", exporters, includeStateFile)
-
-		if resourceTypeJSONMaps[resource.Type] == nil {
-			resourceTypeJSONMaps[resource.Type] = make(map[string]jsonMap)
+		if verify {
+			typeDifferences, diagErr := verifyExportedResources(ctx, provider, resourcesByType[resType], map[string]map[string]jsonMap{resType: resourceJSONMaps}, manifest, meta)
+			if diagErr != nil {
+				return diagErr
+			}
+			differences = append(differences, typeDifferences...)
 		}
 
-		if len(resourceTypeJSONMaps[resource.Type][resource.Name]) > 0 {
-			algorithm := fnv.New32()
-			algorithm.Write([]byte(uuid.NewString()))
-			resource.Name = resource.Name + "_" + strconv.FormatUint(uint64(algorithm.Sum32()), 10)
+		if diagErr := configWriter.writeResourceType(resType, resourceJSONMaps); diagErr != nil {
+			return diagErr
 		}
+	}
 
-		resourceTypeJSONMaps[resource.Type][resource.Name] = jsonResult
+	var diags diag.Diagnostics
+	if verify {
+		sort.Strings(differences)
+		d.Set("verify_differences", differences)
+		for _, difference := range differences {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Exported config does not match the current state",
+				Detail:   difference,
+			})
+		}
 	}
 
 	providerSource := sourceForVersion(version)
@@ -190,25 +282,24 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 		}
 	}
 
-	rootJSONObject := jsonMap{
-		"resource": resourceTypeJSONMaps,
-		"terraform": jsonMap{
-			"required_providers": jsonMap{
-				"genesyscloud": jsonMap{
-					"source":  providerSource,
-					"version": version,
-				},
+	if err := configWriter.close(jsonMap{
+		"required_providers": jsonMap{
+			"genesyscloud": jsonMap{
+				"source":  providerSource,
+				"version": version,
 			},
 		},
+	}); err != nil {
+		return err
 	}
 
-	if err := writeConfig(rootJSONObject, filePath); err != nil {
+	if err := writeManifest(manifest, d); err != nil {
 		return err
 	}
 
 	d.SetId(filePath)
 
-	return nil
+	return diags
 }
 
 func sourceForVersion(version string) string {
@@ -242,9 +333,53 @@ func deleteTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) di
 		log.Printf("Deleting export state %s", stateFile)
 		os.Remove(stateFile)
 	}
+
+	manifestFile, _ := getFilePath(d, defaultTfManifestFile)
+	if _, err := os.Stat(manifestFile); err == nil {
+		// Only files written by this export are removed. Directories are left in place if they contain other files.
+		deleteExportedFiles(d.Get("directory").(string), manifestFile)
+		log.Printf("Deleting export manifest %s", manifestFile)
+		os.Remove(manifestFile)
+	}
 	return nil
 }
 
+// Deletes the files listed in an export manifest and any export subdirectories left empty
+func deleteExportedFiles(directory string, manifestFile string) {
+	data, err := ioutil.ReadFile(manifestFile)
+	if err != nil {
+		log.Printf("Failed to read export manifest %s: %v", manifestFile, err)
+		return
+	}
+	var manifest struct {
+		Resources []exportManifestEntry `json:"resources"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		log.Printf("Failed to decode export manifest %s: %v", manifestFile, err)
+		return
+	}
+
+	subDirs := make(map[string]bool)
+	for _, entry := range manifest.Resources {
+		for _, file := range entry.Files {
+			relPath := filepath.Clean(filepath.FromSlash(file))
+			if filepath.IsAbs(relPath) || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+				log.Printf("Skipping exported file %s outside of the export directory", file)
+				continue
+			}
+			log.Printf("Deleting exported file %s", file)
+			os.Remove(filepath.Join(directory, relPath))
+			if subDir := filepath.Dir(relPath); subDir != "." {
+				subDirs[subDir] = true
+			}
+		}
+	}
+	for subDir := range subDirs {
+		// Fails if the directory is not empty
+		os.Remove(filepath.Join(directory, subDir))
+	}
+}
+
 func getFilePath(d *schema.ResourceData, filename string) (string, diag.Diagnostics) {
 	directory := d.Get("directory").(string)
 	if err := os.MkdirAll(directory, os.ModePerm); err != nil {
@@ -299,76 +434,233 @@ func buildSanitizedResourceMaps(exporters map[string]*ResourceExporter, filter [
 	}
 }
 
-func getResourcesForType(resType string, provider *schema.Provider, exporter *ResourceExporter, meta interface{}) ([]resourceInfo, diag.Diagnostics) {
-	lenResources := len(exporter.SanitizedResourceMap)
-	errorChan := make(chan diag.Diagnostics, lenResources)
-	resourceChan := make(chan resourceInfo, lenResources)
-	removeChan := make(chan string, lenResources)
+// exportJob identifies a single resource instance to be read by an export worker
+type exportJob struct {
+	ID   string
+	Type string
+	Meta *ResourceMeta
+}
 
-	// Cancel remaining goroutines if an error occurs
-	ctx, cancel := context.WithCancel(context.Background())
+// exportResult is the outcome of reading an exportJob. A nil State indicates
+// the resource no longer exists.
+type exportResult struct {
+	exportJob
+	State  *terraform.InstanceState
+	Config jsonMap
+	Files  []string
+	Err    diag.Diagnostics
+}
+
+// readExportResources reads the state of every resource in the exporters' sanitized maps using a fixed
+// number of workers shared across all resource types. Jobs are queued round-robin by type so small types
+// are not blocked behind large ones. Each resource read is passed to handleResource as soon as it is
+// available so results do not need to be buffered until all reads complete. Once every resource of a type
+// has been read, handleTypeDone is called so results for that type can be written out.
+func readExportResources(
+	ctx context.Context,
+	provider *schema.Provider,
+	exporters map[string]*ResourceExporter,
+	meta interface{},
+	concurrency int,
+	exportDir string,
+	handleResource func(resourceInfo, jsonMap) diag.Diagnostics,
+	handleTypeDone func(resType string) diag.Diagnostics) diag.Diagnostics {
+
+	// Cancel remaining reads if an error occurs
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	resource := provider.ResourcesMap[resType]
-	if resource == nil {
-		return nil, diag.Errorf("Resource type %s not defined", resType)
+	resTypes := make([]string, 0, len(exporters))
+	resources := make(map[string]*schema.Resource, len(exporters))
+	ctyTypes := make(map[string]cty.Type, len(exporters))
+	typeIDs := make(map[string][]string, len(exporters))
+	total := 0
+	for resType, exporter := range exporters {
+		resource := provider.ResourcesMap[resType]
+		if resource == nil {
+			return diag.Errorf("Resource type %s not defined", resType)
+		}
+		resTypes = append(resTypes, resType)
+		resources[resType] = resource
+		ctyTypes[resType] = resource.CoreConfigSchema().ImpliedType()
+
+		ids := make([]string, 0, len(exporter.SanitizedResourceMap))
+		for id := range exporter.SanitizedResourceMap {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		typeIDs[resType] = ids
+		total += len(ids)
 	}
+	sort.Strings(resTypes)
 
-	ctyType := resource.CoreConfigSchema().ImpliedType()
+	if concurrency < 1 {
+		concurrency = 1
+	}
 
-	var wg sync.WaitGroup
-	wg.Add(lenResources)
-	for id, resMeta := range exporter.SanitizedResourceMap {
-		go func(id string, resMeta *ResourceMeta) {
-			defer wg.Done()
+	jobChan := make(chan exportJob)
+	resultChan := make(chan exportResult)
 
-			// This calls into the resource's ReadContext method which
-			// will block until it can acquire a pooled client config object.
-			instanceState, err := getResourceState(ctx, resource, id, resMeta, meta)
-			if err != nil {
-				errorChan <- diag.Errorf("Failed to get state for %s instance %s: %v", resType, id, err)
-				cancel() // Stop other requests
-				return
+	go func() {
+		defer close(jobChan)
+		for i := 0; ; i++ {
+			queued := false
+			for _, resType := range resTypes {
+				ids := typeIDs[resType]
+				if i >= len(ids) {
+					continue
+				}
+				queued = true
+				job := exportJob{
+					ID:   ids[i],
+					Type: resType,
+					Meta: exporters[resType].SanitizedResourceMap[ids[i]],
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case jobChan <- job:
+				}
 			}
-
-			if instanceState == nil {
-				log.Printf("Resource %s no longer exists. Skipping.", resMeta.Name)
-				removeChan <- id // Mark for removal from the map
+			if !queued {
 				return
 			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for job := range jobChan {
+				// This calls into the resource's ReadContext method which
+				// will block until it can acquire a pooled client config object.
+				result := exportResult{exportJob: job}
+				instanceState, err := getResourceState(ctx, resources[job.Type], job.ID, job.Meta, meta)
+				if err != nil {
+					result.Err = diag.Errorf("Failed to get state for %s instance %s: %v", job.Type, job.ID, err)
+				} else if instanceState != nil {
+					result.State = instanceState
+					result.State, result.Config, result.Files, result.Err = exportResourceConfig(ctx, exporters, resources[job.Type], job.Type, job.ID, job.Meta.Name, instanceState, ctyTypes[job.Type], exportDir, meta)
+				}
 
-			resourceChan <- resourceInfo{
-				State:   instanceState,
-				Name:    resMeta.Name,
-				Type:    resType,
-				CtyType: ctyType,
+				select {
+				case <-ctx.Done():
+					return
+				case resultChan <- result:
+				}
 			}
-		}(id, resMeta)
+		}()
 	}
 
 	go func() {
 		wg.Wait()
-		close(resourceChan)
-		close(removeChan)
+		close(resultChan)
 	}()
 
-	var resources []resourceInfo
-	for resource := range resourceChan {
-		resources = append(resources, resource)
+	var firstErr diag.Diagnostics
+	removed := make(map[string][]string)
+	remaining := make(map[string]int, len(typeIDs))
+	for resType, ids := range typeIDs {
+		remaining[resType] = len(ids)
 	}
+	processed := 0
+	for result := range resultChan {
+		if firstErr != nil {
+			// Drain remaining results after an error
+			continue
+		}
+		processed++
 
-	// Remove resources that weren't found in this pass
-	for id := range removeChan {
-		delete(exporter.SanitizedResourceMap, id)
+		if result.Err != nil {
+			firstErr = result.Err
+			cancel() // Stop other requests
+			continue
+		}
+
+		if result.State == nil {
+			log.Printf("Resource %s no longer exists. Skipping.", result.Meta.Name)
+			removed[result.Type] = append(removed[result.Type], result.ID)
+		} else if err := handleResource(resourceInfo{
+			State:   result.State,
+			ID:      result.ID,
+			Name:    result.Meta.Name,
+			Type:    result.Type,
+			CtyType: ctyTypes[result.Type],
+			Files:   result.Files,
+		}, result.Config); err != nil {
+			firstErr = err
+			cancel()
+			continue
+		}
+
+		remaining[result.Type]--
+		if remaining[result.Type] == 0 && handleTypeDone != nil {
+			if err := handleTypeDone(result.Type); err != nil {
+				firstErr = err
+				cancel()
+				continue
+			}
+		}
+
+		if processed%100 == 0 {
+			log.Printf("Read %d of %d resources for export", processed, total)
+		}
 	}
 
-	// Return the first error if one was received
-	select {
-	case err := <-errorChan:
-		return nil, err
-	default:
-		return resources, nil
+	if firstErr != nil {
+		return firstErr
+	}
+
+	// Remove resources that weren't found so they are no longer used as reference targets
+	for resType, ids := range removed {
+		for _, id := range ids {
+			delete(exporters[resType].SanitizedResourceMap, id)
+		}
 	}
+	log.Printf("Read %d resources for export", processed)
+	return nil
+}
+
+// exportResourceConfig generates the unsanitized config map for a resource and exports any files it references.
+// If exported files change the config, the returned state is rebuilt from the config so the two stay in sync.
+func exportResourceConfig(
+	ctx context.Context,
+	exporters map[string]*ResourceExporter,
+	resource *schema.Resource,
+	resType string,
+	id string,
+	resourceName string,
+	state *terraform.InstanceState,
+	ctyType cty.Type,
+	exportDir string,
+	meta interface{}) (*terraform.InstanceState, jsonMap, []string, diag.Diagnostics) {
+	exporter := exporters[resType]
+	if err := setReferenceFallbacks(ctx, exporters, exporter, state, meta); err != nil {
+		return nil, nil, nil, err
+	}
+
+	configMap, err := instanceStateToJSONMap(state, ctyType)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var files []string
+	if exporter.ExportFilesFunc != nil {
+		files, err = exporter.ExportFilesFunc(ctx, id, resourceName, configMap, exportDir, meta)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if len(files) > 0 {
+			// File paths in the config point to the exported files, so the exported state must use them too
+			state, err = jsonMapToInstanceState(resource, state, configMap)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		}
+	}
+	return state, configMap, files, nil
 }
 
 func getResourceState(ctx context.Context, resource *schema.Resource, resID string, resMeta *ResourceMeta, meta interface{}) (*terraform.InstanceState, diag.Diagnostics) {
@@ -398,6 +690,92 @@ func getResourceState(ctx context.Context, resource *schema.Resource, resID stri
 	return state, nil
 }
 
+// Matches a sanitized reference expression to another resource's ID
+var exportReferencePattern = regexp.MustCompile(`^\$\{([^}]+)\.id\}$`)
+
+// verifyExportedResources compares the sanitized config of each exported resource against its current state
+// and returns a description of each attribute that would change if the config were applied
+func verifyExportedResources(
+	ctx context.Context,
+	provider *schema.Provider,
+	resources []resourceInfo,
+	resourceTypeJSONMaps map[string]map[string]jsonMap,
+	manifest map[string]*exportManifestEntry,
+	meta interface{}) ([]string, diag.Diagnostics) {
+
+	addressIDs := make(map[string]string, len(manifest))
+	for address, entry := range manifest {
+		addressIDs[address] = entry.ID
+	}
+
+	differences := []string{}
+	for _, resource := range resources {
+		address := resource.Type + "." + resource.Name
+		configMap := resourceTypeJSONMaps[resource.Type][resource.Name]
+		if configMap == nil {
+			continue
+		}
+
+		// Replace reference expressions with the referenced IDs so the config can be compared to the state
+		config := buildVerifyConfig(configMap, addressIDs).(map[string]interface{})
+		diff, err := provider.ResourcesMap[resource.Type].SimpleDiff(ctx, resource.State, terraform.NewResourceConfigRaw(config), meta)
+		if err != nil {
+			return nil, diag.Errorf("Failed to verify exported config for %s: %v", address, err)
+		}
+		if diff == nil || diff.Empty() {
+			continue
+		}
+
+		for key, attrDiff := range diff.Attributes {
+			if attrDiff == nil || attrDiff.NewComputed {
+				continue
+			}
+			newValue := strconv.Quote(attrDiff.New)
+			if attrDiff.NewRemoved {
+				newValue = "(removed)"
+			}
+			differences = append(differences, fmt.Sprintf("%s.%s: %s => %s", address, key, strconv.Quote(attrDiff.Old), newValue))
+		}
+	}
+	sort.Strings(differences)
+	return differences, nil
+}
+
+// buildVerifyConfig returns a copy of a sanitized config value with null values removed,
+// references replaced by IDs, and escaped template sequences restored
+func buildVerifyConfig(val interface{}, addressIDs map[string]string) interface{} {
+	switch val := val.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(val))
+		for k, v := range val {
+			if v != nil {
+				result[k] = buildVerifyConfig(v, addressIDs)
+			}
+		}
+		return result
+	case jsonMap:
+		return buildVerifyConfig(map[string]interface{}(val), addressIDs)
+	case []interface{}:
+		result := make([]interface{}, 0, len(val))
+		for _, v := range val {
+			if v != nil {
+				result = append(result, buildVerifyConfig(v, addressIDs))
+			}
+		}
+		return result
+	case string:
+		if match := exportReferencePattern.FindStringSubmatch(val); match != nil {
+			if id, ok := addressIDs[match[1]]; ok {
+				return id
+			}
+		}
+		unescapedVal := strings.ReplaceAll(val, "$${", "${")
+		return strings.ReplaceAll(unescapedVal, "%%{", "%{")
+	default:
+		return val
+	}
+}
+
 func instanceStateToJSONMap(state *terraform.InstanceState, ctyType cty.Type) (jsonMap, diag.Diagnostics) {
 	stateVal, err := schema.StateValueFromInstanceState(state, ctyType)
 	if err != nil {
@@ -411,6 +789,21 @@ func instanceStateToJSONMap(state *terraform.InstanceState, ctyType cty.Type) (j
 	return jsonMap, nil
 }
 
+func jsonMapToInstanceState(resource *schema.Resource, state *terraform.InstanceState, configMap jsonMap) (*terraform.InstanceState, diag.Diagnostics) {
+	stateVal, err := schema.JSONMapToStateValue(configMap, resource.CoreConfigSchema())
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	newState, err := resource.ShimInstanceStateFromValue(stateVal)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	newState.ID = state.ID
+	newState.Meta = state.Meta
+	return newState, nil
+}
+
 func writeToFile(bytes []byte, path string) diag.Diagnostics {
 	err := ioutil.WriteFile(path, bytes, os.ModePerm)
 	if err != nil {
@@ -491,16 +884,144 @@ func writeTfState(ctx context.Context, resources []resourceInfo, d *schema.Resou
 	return nil
 }
 
-func writeConfig(jsonMap map[string]interface{}, path string) diag.Diagnostics {
-	dataJSONBytes, err := json.MarshalIndent(jsonMap, "", "  ")
+// Writes a manifest of every exported resource sorted by address
+func writeManifest(manifest map[string]*exportManifestEntry, d *schema.ResourceData) diag.Diagnostics {
+	manifestFilePath, diagErr := getFilePath(d, defaultTfManifestFile)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	addresses := make([]string, 0, len(manifest))
+	for address := range manifest {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	entries := make([]*exportManifestEntry, len(addresses))
+	for i, address := range addresses {
+		entries[i] = manifest[address]
+	}
+
+	data, err := json.MarshalIndent(jsonMap{"resources": entries}, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode manifest as JSON: %v", err)
+	}
+
+	log.Printf("Writing export manifest file to %s", manifestFilePath)
+	return writeToFile(data, manifestFilePath)
+}
+
+// Partial config for each resource type is written to a temporary directory until all resources have been read
+func writePartialConfig(partialDir string, resType string, resourceJSONMaps map[string]jsonMap) diag.Diagnostics {
+	data, err := json.Marshal(resourceJSONMaps)
+	if err != nil {
+		return diag.Errorf("Failed to encode config for %s as JSON: %v", resType, err)
+	}
+	log.Printf("Writing partial export config for %d %s resources", len(resourceJSONMaps), resType)
+	return writeToFile(data, filepath.Join(partialDir, resType+".json"))
+}
+
+func readPartialConfig(partialDir string, resType string) (map[string]jsonMap, diag.Diagnostics) {
+	data, err := ioutil.ReadFile(filepath.Join(partialDir, resType+".json"))
+	if err != nil {
+		return nil, diag.Errorf("Failed to read partial export config for %s: %v", resType, err)
+	}
+	var resourceJSONMaps map[string]jsonMap
+	if err := json.Unmarshal(data, &resourceJSONMaps); err != nil {
+		return nil, diag.Errorf("Failed to decode partial export config for %s: %v", resType, err)
+	}
+	return resourceJSONMaps, nil
+}
+
+// exportConfigWriter writes the export config file one resource type at a time. The output is
+// the same as indenting the full config object, without holding the config of every type in memory.
+type exportConfigWriter struct {
+	file      *os.File
+	typeCount int
+}
+
+func newExportConfigWriter(path string) (*exportConfigWriter, diag.Diagnostics) {
+	log.Printf("Writing export config file to %s", path)
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, diag.Errorf("Error writing file %s: %v", path, err)
+	}
+	writer := &exportConfigWriter{file: file}
+	if err := writer.write("{\n  \"resource\": "); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (w *exportConfigWriter) write(data string) diag.Diagnostics {
+	if _, err := w.file.WriteString(data); err != nil {
+		return diag.Errorf("Error writing file %s: %v", w.file.Name(), err)
+	}
+	return nil
+}
+
+// Types must be written in sorted order
+func (w *exportConfigWriter) writeResourceType(resType string, resourceJSONMaps map[string]jsonMap) diag.Diagnostics {
+	typeKey, err := json.Marshal(resType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	typeJSON, err := json.MarshalIndent(resourceJSONMaps, "    ", "  ")
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	log.Printf("Writing export config file to %s", path)
-	if err := writeToFile(dataJSONBytes, path); err != nil {
+	separator := ",\n    "
+	if w.typeCount == 0 {
+		separator = "{\n    "
+	}
+	w.typeCount++
+	return w.write(separator + string(typeKey) + ": " + string(typeJSON))
+}
+
+// Writes the terraform settings and closes the config file
+func (w *exportConfigWriter) close(terraformSettings jsonMap) diag.Diagnostics {
+	terraformJSON, err := json.MarshalIndent(terraformSettings, "  ", "  ")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceEnd := "\n  }"
+	if w.typeCount == 0 {
+		resourceEnd = "{}"
+	}
+	if err := w.write(resourceEnd + ",\n  \"terraform\": " + string(terraformJSON) + "\n}"); err != nil {
 		return err
 	}
+	if err := w.file.Close(); err != nil {
+		return diag.Errorf("Error writing file %s: %v", w.file.Name(), err)
+	}
+	return nil
+}
+
+// setReferenceFallbacks replaces references to objects that are not exported with their fallback attributes.
+// The state is updated so the exported config and state match.
+func setReferenceFallbacks(ctx context.Context, exporters map[string]*ResourceExporter, exporter *ResourceExporter, state *terraform.InstanceState, meta interface{}) diag.Diagnostics {
+	for attr, refSettings := range exporter.RefAttrs {
+		if refSettings.FallbackAttr == "" || refSettings.FallbackValueFunc == nil {
+			continue
+		}
+		refID := state.Attributes[attr]
+		if refID == "" || stringInSlice(refID, refSettings.AltValues) || isExported(exporters, refSettings.RefType, refID) {
+			continue
+		}
+
+		fallbackValue, err := refSettings.FallbackValueFunc(ctx, refID, meta)
+		if err != nil {
+			return err
+		}
+		if fallbackValue == "" {
+			continue
+		}
+		state.Attributes[attr] = ""
+		state.Attributes[refSettings.FallbackAttr] = fallbackValue
+	}
 	return nil
 }
 
@@ -514,7 +1035,8 @@ func sanitizeConfigMap(
 	configMap map[string]interface{},
 	prevAttr string,
 	exporters map[string]*ResourceExporter,
-	exportingState bool) bool {
+	exportingState bool,
+	manifest *exportManifestEntry) bool {
 
 	exporter := exporters[resourceType]
 	for key, val := range configMap {
@@ -537,16 +1059,22 @@ func sanitizeConfigMap(
 			continue
 		}
 
+		if exporter.isComputedOnly(currAttr) {
+			// Computed-only attributes cannot be set in config
+			configMap[key] = nil
+			continue
+		}
+
 		switch val.(type) {
 		case map[string]interface{}:
 			// Maps are sanitized in-place
 			currMap := val.(map[string]interface{})
-			if !sanitizeConfigMap(resourceType, val.(map[string]interface{}), currAttr, exporters, exportingState) || len(currMap) == 0 {
+			if !sanitizeConfigMap(resourceType, val.(map[string]interface{}), currAttr, exporters, exportingState, manifest) || len(currMap) == 0 {
 				// Remove empty maps or maps indicating they should be removed
 				configMap[key] = nil
 			}
 		case []interface{}:
-			if arr := sanitizeConfigArray(resourceType, val.([]interface{}), currAttr, exporters, exportingState); len(arr) > 0 {
+			if arr := sanitizeConfigArray(resourceType, val.([]interface{}), currAttr, exporters, exportingState, manifest); len(arr) > 0 {
 				configMap[key] = arr
 			} else {
 				// Remove empty arrays
@@ -559,8 +1087,9 @@ func sanitizeConfigMap(
 				// Check for wildcard attribute indicating all attributes in the map
 				refSettings = exporter.getRefAttrSettings(wildcardAttr)
 			}
-			if refSettings != nil {
+			if refSettings != nil && refSettings.appliesTo(configMap) {
 				configMap[key] = resolveReference(refSettings, val.(string), exporters, exportingState)
+				manifest.addReference(currAttr, refSettings, val.(string), configMap[key].(string))
 			} else {
 				configMap[key] = escapeString(val.(string))
 			}
@@ -568,9 +1097,9 @@ func sanitizeConfigMap(
 
 		// The plugin SDK does not yet have a concept of "null" for unset attributes, so they are saved in state as their "zero value".
 		// This can cause invalid config files due to including attributes with limits that don't allow for zero values, so we remove
-		// those attributes from the config by default. Attributes can opt-out of this behavior by being added to a ResourceExporter's
-		// AllowZeroValues list.
-		if !exporter.allowZeroValues(currAttr) {
+		// those attributes from the config unless the resource schema indicates the zero value is meaningful. Attributes can also
+		// opt-out of this behavior by being added to a ResourceExporter's AllowZeroValues list.
+		if !exporter.allowZeroValues(currAttr) && !exporter.keepZeroValue(currAttr) {
 			removeZeroValues(key, configMap[key], configMap)
 		}
 	}
@@ -613,7 +1142,8 @@ func sanitizeConfigArray(
 	anArray []interface{},
 	currAttr string,
 	exporters map[string]*ResourceExporter,
-	exportingState bool) []interface{} {
+	exportingState bool,
+	manifest *exportManifestEntry) []interface{} {
 	exporter := exporters[resourceType]
 	result := []interface{}{}
 	for _, val := range anArray {
@@ -621,17 +1151,18 @@ func sanitizeConfigArray(
 		case map[string]interface{}:
 			// Only include in the result if sanitizeConfigMap returns true and the map is not empty
 			currMap := val.(map[string]interface{})
-			if sanitizeConfigMap(resourceType, currMap, currAttr, exporters, exportingState) && len(currMap) > 0 {
+			if sanitizeConfigMap(resourceType, currMap, currAttr, exporters, exportingState, manifest) && len(currMap) > 0 {
 				result = append(result, val)
 			}
 		case []interface{}:
-			if arr := sanitizeConfigArray(resourceType, val.([]interface{}), currAttr, exporters, exportingState); len(arr) > 0 {
+			if arr := sanitizeConfigArray(resourceType, val.([]interface{}), currAttr, exporters, exportingState, manifest); len(arr) > 0 {
 				result = append(result, arr)
 			}
 		case string:
 			// Check if we are on a reference attribute and update value in array
 			if refSettings := exporter.getRefAttrSettings(currAttr); refSettings != nil {
 				referenceVal := resolveReference(refSettings, val.(string), exporters, exportingState)
+				manifest.addReference(currAttr, refSettings, val.(string), referenceVal)
 				if referenceVal != "" {
 					result = append(result, referenceVal)
 				}
@@ -660,7 +1191,7 @@ func resolveReference(refSettings *RefAttrSettings, refID string, exporters map[
 		}
 	}
 
-	if exportingState {
+	if exportingState || refSettings.KeepUnresolved {
 		// Don't remove unmatched IDs when exporting state. This will keep existing config in an org
 		return refID
 	}
@@ -1,17 +1,25 @@
 package genesyscloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"gonum.org/v1/gonum/graph/simple"
 	"gonum.org/v1/gonum/graph/topo"
@@ -36,6 +44,7 @@ func TestAccResourceTfExport(t *testing.T) {
 		exportResource1 = "test-export1"
 		configPath      = filepath.Join(exportTestDir, defaultTfJSONFile)
 		statePath       = filepath.Join(exportTestDir, defaultTfStateFile)
+		manifestPath    = filepath.Join(exportTestDir, defaultTfManifestFile)
 	)
 
 	resource.Test(t, resource.TestCase{
@@ -53,6 +62,7 @@ func TestAccResourceTfExport(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					validateFileCreated(configPath),
 					validateConfigFile(configPath),
+					validateFileCreated(manifestPath),
 				),
 			},
 			{
@@ -272,6 +282,150 @@ func TestAccResourceTfExportByName(t *testing.T) {
 	})
 }
 
+func TestAccResourceTfExportUserPromptAudioFiles(t *testing.T) {
+	var (
+		exportResource1 = "test-export-prompts"
+
+		userPromptResource1 = "test-user-prompt"
+		userPromptName1     = "TestExportPrompt" + strings.Replace(uuid.NewString(), "-", "", -1)
+		userPromptLang1     = "en-us"
+	)
+
+	userPromptAsset1 := userPromptResourceStruct{
+		userPromptLang1,
+		nullValue,
+		strconv.Quote("This is a test greeting!"),
+		strconv.Quote("test-prompt-01.wav"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a prompt with audio first so the upload has time to be transcoded
+				Config: generateUserPromptResource(&userPromptStruct{
+					userPromptResource1,
+					userPromptName1,
+					strconv.Quote("Test prompt export"),
+					[]*userPromptResourceStruct{&userPromptAsset1},
+				}),
+			},
+			{
+				// Export the prompt with its audio file
+				Config: generateUserPromptResource(&userPromptStruct{
+					userPromptResource1,
+					userPromptName1,
+					strconv.Quote("Test prompt export"),
+					[]*userPromptResourceStruct{&userPromptAsset1},
+				}) + generateTfExportByName(
+					exportResource1,
+					exportTestDir,
+					falseValue,
+					[]string{strconv.Quote("genesyscloud_architect_user_prompt::" + userPromptName1)},
+					"",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testUserPromptAudioFileExport(exportTestDir, sanitizeResourceName(userPromptName1), userPromptLang1),
+				),
+			},
+		},
+		CheckDestroy: testVerifyExportsDestroyed,
+	})
+}
+
+func testUserPromptAudioFileExport(exportDir, resourceName, language string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		raw, err := getResourceDefinition(filepath.Join(exportDir, defaultTfJSONFile), "genesyscloud_architect_user_prompt")
+		if err != nil {
+			return err
+		}
+		if raw[resourceName] == nil {
+			return fmt.Errorf("user prompt %s not found in the config file", resourceName)
+		}
+
+		var exportedPrompt struct {
+			Resources []struct {
+				Language string `json:"language"`
+				Filename string `json:"filename"`
+			} `json:"resources"`
+		}
+		if err := json.Unmarshal(*raw[resourceName], &exportedPrompt); err != nil {
+			return err
+		}
+
+		for _, r := range exportedPrompt.Resources {
+			if r.Language != language {
+				continue
+			}
+			if !strings.HasPrefix(r.Filename, userPromptExportDir+"/") {
+				return fmt.Errorf("expected exported filename to reference the %s directory. Got: %s", userPromptExportDir, r.Filename)
+			}
+			if _, err := os.Stat(filepath.Join(exportDir, r.Filename)); err != nil {
+				return fmt.Errorf("failed to find exported audio file %s: %v", r.Filename, err)
+			}
+			return nil
+		}
+		return fmt.Errorf("no exported resource found for language %s", language)
+	}
+}
+
+func TestAccResourceTfExportVerify(t *testing.T) {
+	var (
+		exportResource1 = "test-export-verify"
+
+		userResource1 = "test-user1"
+		userEmail1    = "terraform-" + uuid.NewString() + "@example.com"
+		userName1     = "John Data-" + uuid.NewString()
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Export a user with verification to ensure the config round-trips without changes
+				Config: generateBasicUserResource(
+					userResource1,
+					userEmail1,
+					userName1,
+				) + fmt.Sprintf(`resource "genesyscloud_tf_export" "%s" {
+					directory = "%s"
+					include_state_file = true
+					resource_types = [%s]
+					verify = true
+				}
+				`, exportResource1, exportTestDir, strconv.Quote("genesyscloud_user::"+userEmail1)),
+				Check: resource.ComposeTestCheckFunc(
+					testVerifyExportDifferences("genesyscloud_tf_export." + exportResource1),
+				),
+			},
+		},
+		CheckDestroy: testVerifyExportsDestroyed,
+	})
+}
+
+// Fails if an export run with verify = true reported any attributes that would change on apply
+func testVerifyExportDifferences(exportResourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		r := state.RootModule().Resources[exportResourceName]
+		if r == nil {
+			return fmt.Errorf("%s not found in state", exportResourceName)
+		}
+
+		numDiffs, _ := strconv.Atoi(r.Primary.Attributes["verify_differences.#"])
+		if numDiffs == 0 {
+			return nil
+		}
+
+		differences := make([]string, numDiffs)
+		for i := 0; i < numDiffs; i++ {
+			differences[i] = r.Primary.Attributes["verify_differences."+strconv.Itoa(i)]
+		}
+		return fmt.Errorf("exported config does not match state:\n%s", strings.Join(differences, "\n"))
+	}
+}
+
 func testUserExport(filePath, resourceType, resourceName string, expectedUser *UserExport) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		raw, err := getResourceDefinition(filePath, resourceType)
@@ -365,6 +519,109 @@ func getResourceDefinition(filePath, resourceType string) (map[string]*json.RawM
 	return r, nil
 }
 
+func TestUnitResourceTfExport(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+
+	// Create more skills than fit in a single page
+	const skillCount = 120
+	for i := 0; i < skillCount; i++ {
+		fakeAPI.create("/api/v2/routing/skills", map[string]interface{}{"name": fmt.Sprintf("Unit Test Skill %d", i)})
+	}
+
+	exportDir := t.TempDir()
+	exportResource := resourceTfExport()
+	d := schema.TestResourceDataRaw(t, exportResource.Schema, map[string]interface{}{
+		"directory":      exportDir,
+		"resource_types": []interface{}{"genesyscloud_routing_skill"},
+	})
+	if diagErr := exportResource.CreateContext(context.Background(), d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to export skills: %v", diagErr)
+	}
+
+	skills, err := getResourceDefinition(filepath.Join(exportDir, defaultTfJSONFile), "genesyscloud_routing_skill")
+	if err != nil {
+		t.Fatalf("Failed to read exported skills: %v", err)
+	}
+	if len(skills) != skillCount {
+		t.Fatalf("Expected %d exported skills, got %d", skillCount, len(skills))
+	}
+
+	var config map[string]string
+	if err := json.Unmarshal(*skills[sanitizeResourceName("Unit Test Skill 7")], &config); err != nil {
+		t.Fatalf("Failed to parse exported skill: %v", err)
+	}
+	if config["name"] != "Unit Test Skill 7" {
+		t.Fatalf("Expected exported skill name 'Unit Test Skill 7', got '%s'", config["name"])
+	}
+}
+
+// Verify exported prompt audio is referenced by both the exported config and state so verify reports no differences
+func TestUnitResourceTfExportUserPromptAudio(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+
+	mediaServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("fake audio"))
+	}))
+	defer mediaServer.Close()
+
+	fakeAPI.create("/api/v2/architect/prompts", map[string]interface{}{
+		"name":        "Welcome",
+		"description": "Welcome prompt",
+		"resources": []interface{}{
+			map[string]interface{}{
+				"language":     "en-us",
+				"text":         "Welcome",
+				"mediaUri":     mediaServer.URL + "/welcome.wav",
+				"uploadStatus": "transcoded",
+				"tags":         map[string]interface{}{"filename": []interface{}{"/original/path/welcome.wav"}},
+			},
+		},
+	})
+
+	exportDir := t.TempDir()
+	exportResource := resourceTfExport()
+	d := schema.TestResourceDataRaw(t, exportResource.Schema, map[string]interface{}{
+		"directory":          exportDir,
+		"resource_types":     []interface{}{"genesyscloud_architect_user_prompt"},
+		"include_state_file": true,
+		"verify":             true,
+	})
+	if diagErr := exportResource.CreateContext(context.Background(), d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to export user prompts: %v", diagErr)
+	}
+
+	if differences := d.Get("verify_differences").([]interface{}); len(differences) != 0 {
+		t.Errorf("Expected no verify differences, got %v", differences)
+	}
+
+	exportedPath := userPromptExportDir + "/Welcome-en-us.wav"
+	if _, err := os.Stat(filepath.Join(exportDir, exportedPath)); err != nil {
+		t.Errorf("Expected exported prompt audio file: %v", err)
+	}
+
+	stateData, err := ioutil.ReadFile(filepath.Join(exportDir, defaultTfStateFile))
+	if err != nil {
+		t.Fatalf("Failed to read exported state: %v", err)
+	}
+	var exportedState terraform.State
+	if err := json.Unmarshal(stateData, &exportedState); err != nil {
+		t.Fatalf("Failed to parse exported state: %v", err)
+	}
+	promptState := exportedState.RootModule().Resources["genesyscloud_architect_user_prompt.Welcome"]
+	if promptState == nil {
+		t.Fatalf("Expected exported state for the prompt")
+	}
+	var filenames []string
+	for key, value := range promptState.Primary.Attributes {
+		if strings.HasPrefix(key, "resources.") && strings.HasSuffix(key, ".filename") {
+			filenames = append(filenames, value)
+		}
+	}
+	if len(filenames) != 1 || filenames[0] != exportedPath {
+		t.Errorf("Expected exported state filename %s, got %v", exportedPath, filenames)
+	}
+}
+
 // Create a directed graph of exported resources to their references. Report any potential graph cycles in this test.
 // Reference cycles can sometimes be broken by exporting a separate resource to update membership after the member
 // and container resources are created/updated (see genesyscloud_user_roles).
@@ -417,6 +674,494 @@ func TestForExportCycles(t *testing.T) {
 	}
 }
 
+// Verify the export pipeline reads every resource across types without exceeding the configured concurrency
+// and drops resources that no longer exist from the sanitized resource maps.
+func TestExportResourcesConcurrency(t *testing.T) {
+	const maxConcurrency = 3
+
+	var (
+		mu      sync.Mutex
+		active  int
+		maxSeen int
+	)
+	testReadFunc := func(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		mu.Lock()
+		active++
+		if active > maxSeen {
+			maxSeen = active
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+
+		if strings.HasPrefix(d.Id(), "missing") {
+			d.SetId("")
+			return nil
+		}
+		d.Set("name", "name-"+d.Id())
+		return nil
+	}
+	testResource := func() *schema.Resource {
+		return &schema.Resource{
+			ReadContext: testReadFunc,
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Optional: true},
+			},
+		}
+	}
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"test_large": testResource(),
+			"test_small": testResource(),
+		},
+	}
+
+	exporters := map[string]*ResourceExporter{
+		"test_large": {SanitizedResourceMap: ResourceIDMetaMap{}},
+		"test_small": {SanitizedResourceMap: ResourceIDMetaMap{
+			"small-1":   {Name: "small_1"},
+			"missing-1": {Name: "missing_1"},
+		}},
+	}
+	for i := 0; i < 50; i++ {
+		id := fmt.Sprintf("large-%d", i)
+		exporters["test_large"].SanitizedResourceMap[id] = &ResourceMeta{Name: id}
+	}
+
+	read := make(map[string]int)
+	readWhenDone := make(map[string]int)
+	err := readExportResources(context.Background(), provider, exporters, nil, maxConcurrency, "", func(resource resourceInfo, _ jsonMap) diag.Diagnostics {
+		if _, done := readWhenDone[resource.Type]; done {
+			t.Errorf("Resource %s read after its type was done", resource.ID)
+		}
+		read[resource.Type]++
+		return nil
+	}, func(resType string) diag.Diagnostics {
+		readWhenDone[resType] = read[resType]
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected export error: %v", err)
+	}
+
+	if read["test_large"] != 50 || read["test_small"] != 1 {
+		t.Errorf("Unexpected resources read: %v", read)
+	}
+	if readWhenDone["test_large"] != 50 || readWhenDone["test_small"] != 1 {
+		t.Errorf("Expected each type to be done once all of its resources were read, got %v", readWhenDone)
+	}
+	if maxSeen > maxConcurrency {
+		t.Errorf("Expected at most %d concurrent reads, found %d", maxConcurrency, maxSeen)
+	}
+	if _, ok := exporters["test_small"].SanitizedResourceMap["missing-1"]; ok {
+		t.Errorf("Expected missing resource to be removed from the sanitized resource map")
+	}
+}
+
+// Verify config written one type at a time matches the indented JSON of the full config
+func TestExportConfigWriter(t *testing.T) {
+	terraformSettings := jsonMap{"required_providers": jsonMap{"genesyscloud": jsonMap{"version": "0.1.0"}}}
+	resourceTypeJSONMaps := map[string]map[string]jsonMap{
+		"genesyscloud_routing_skill": {"skill_1": {"name": "skill 1"}},
+		"genesyscloud_user":          {"user_1": {"name": "user 1", "routing_skills": []interface{}{jsonMap{"proficiency": 1.0}}}, "user_2": {"name": "<user 2>"}},
+	}
+
+	for _, resTypes := range [][]string{{}, {"genesyscloud_routing_skill", "genesyscloud_user"}} {
+		path := filepath.Join(t.TempDir(), defaultTfJSONFile)
+		writer, diagErr := newExportConfigWriter(path)
+		if diagErr != nil {
+			t.Fatalf("Failed to create config writer: %v", diagErr)
+		}
+		expectedResources := make(map[string]map[string]jsonMap)
+		for _, resType := range resTypes {
+			expectedResources[resType] = resourceTypeJSONMaps[resType]
+			if diagErr := writer.writeResourceType(resType, resourceTypeJSONMaps[resType]); diagErr != nil {
+				t.Fatalf("Failed to write %s: %v", resType, diagErr)
+			}
+		}
+		if diagErr := writer.close(terraformSettings); diagErr != nil {
+			t.Fatalf("Failed to close config writer: %v", diagErr)
+		}
+
+		expected, _ := json.MarshalIndent(jsonMap{"resource": expectedResources, "terraform": terraformSettings}, "", "  ")
+		written, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Failed to read config: %v", err)
+		}
+		if string(written) != string(expected) {
+			t.Errorf("Expected config:\n%s\ngot:\n%s", expected, written)
+		}
+	}
+}
+
+// Verify objects with the same name are exported with unique, consistent resource names
+func TestExportDuplicateResourceNames(t *testing.T) {
+	resources := ResourceIDMetaMap{
+		"prompt-1": {Name: "Welcome"},
+		"prompt-2": {Name: "Welcome"},
+		"prompt-3": {Name: "Goodbye"},
+	}
+	sanitizeResourceNames(resources)
+
+	if resources["prompt-1"].Name == resources["prompt-2"].Name {
+		t.Errorf("Expected unique names for objects with the same name, got %s", resources["prompt-1"].Name)
+	}
+	if resources["prompt-1"].OriginalName != "Welcome" || resources["prompt-3"].Name != "Goodbye" {
+		t.Errorf("Unexpected sanitized names: %+v, %+v", resources["prompt-1"], resources["prompt-3"])
+	}
+
+	again := ResourceIDMetaMap{"prompt-1": {Name: "Welcome"}, "prompt-2": {Name: "Welcome"}}
+	sanitizeResourceNames(again)
+	if again["prompt-1"].Name != resources["prompt-1"].Name {
+		t.Errorf("Expected consistent names between exports, got %s and %s", again["prompt-1"].Name, resources["prompt-1"].Name)
+	}
+}
+
+// Verify deleting an export only removes the files it wrote
+func TestExportDeleteOnlyExportedFiles(t *testing.T) {
+	exportDir := t.TempDir()
+	promptDir := filepath.Join(exportDir, userPromptExportDir)
+	os.MkdirAll(promptDir, os.ModePerm)
+	for _, file := range []string{"Welcome-en-us.wav", "my-recording.wav"} {
+		ioutil.WriteFile(filepath.Join(promptDir, file), []byte("audio"), os.ModePerm)
+	}
+
+	exportResource := resourceTfExport()
+	d := schema.TestResourceDataRaw(t, exportResource.Schema, map[string]interface{}{"directory": exportDir})
+	manifest := map[string]*exportManifestEntry{
+		"genesyscloud_architect_user_prompt.Welcome": {Files: []string{"prompts/Welcome-en-us.wav", "../outside.wav"}},
+	}
+	if diagErr := writeManifest(manifest, d); diagErr != nil {
+		t.Fatalf("Failed to write manifest: %v", diagErr)
+	}
+
+	if diagErr := deleteTfExport(context.Background(), d, nil); diagErr != nil {
+		t.Fatalf("Failed to delete export: %v", diagErr)
+	}
+	if _, err := os.Stat(filepath.Join(promptDir, "Welcome-en-us.wav")); !os.IsNotExist(err) {
+		t.Errorf("Expected exported prompt file to be deleted")
+	}
+	if _, err := os.Stat(filepath.Join(promptDir, "my-recording.wav")); err != nil {
+		t.Errorf("Expected other files in the prompts directory to be kept: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(exportDir, defaultTfManifestFile)); !os.IsNotExist(err) {
+		t.Errorf("Expected export manifest to be deleted")
+	}
+}
+
+func TestExportQueueMemberGroupReferences(t *testing.T) {
+	exporters := getResourceExporters([]string{
+		"genesyscloud_group",
+		"genesyscloud_routing_queue",
+	})
+	exporters["genesyscloud_group"].SanitizedResourceMap = ResourceIDMetaMap{
+		"group-1": {Name: "support"},
+	}
+
+	queueConfig := jsonMap{
+		"name": "Support",
+		"member_groups": []interface{}{
+			map[string]interface{}{"group_id": "group-1", "type": memberGroupTypeGroup},
+			map[string]interface{}{"group_id": "team-1", "type": memberGroupTypeTeam},
+		},
+	}
+	sanitizeConfigMap("genesyscloud_routing_queue", queueConfig, "", exporters, false, nil)
+
+	memberGroups := queueConfig["member_groups"].([]interface{})
+	if len(memberGroups) != 2 {
+		t.Fatalf("Expected 2 member groups, got %v", memberGroups)
+	}
+	for _, memberGroup := range memberGroups {
+		memberGroupMap := memberGroup.(map[string]interface{})
+		switch memberGroupMap["type"] {
+		case memberGroupTypeGroup:
+			if memberGroupMap["group_id"] != "${genesyscloud_group.support.id}" {
+				t.Errorf("Unexpected group reference: %v", memberGroupMap["group_id"])
+			}
+		case memberGroupTypeTeam:
+			// Teams are not groups and must not be removed as missing group references
+			if memberGroupMap["group_id"] != "team-1" {
+				t.Errorf("Unexpected team ID: %v", memberGroupMap["group_id"])
+			}
+		}
+	}
+}
+
+func TestExportConditionalGroupRoutingReferences(t *testing.T) {
+	exporters := getResourceExporters([]string{
+		"genesyscloud_group",
+		"genesyscloud_routing_queue",
+		"genesyscloud_routing_queue_conditional_group_routing",
+	})
+	exporters["genesyscloud_group"].SanitizedResourceMap = ResourceIDMetaMap{
+		"group-1": {Name: "support"},
+	}
+
+	routingConfig := jsonMap{
+		"rules": []interface{}{
+			map[string]interface{}{
+				"operator": "LessThan",
+				"groups": []interface{}{
+					map[string]interface{}{"member_group_id": "group-1", "member_group_type": memberGroupTypeGroup},
+					map[string]interface{}{"member_group_id": "team-1", "member_group_type": memberGroupTypeTeam},
+					map[string]interface{}{"member_group_id": "skill-group-1", "member_group_type": memberGroupTypeSkillGroup},
+				},
+			},
+			map[string]interface{}{
+				"operator":           "LessThan",
+				"evaluated_queue_id": "queue-missing",
+				"groups": []interface{}{
+					map[string]interface{}{"member_group_id": "group-missing", "member_group_type": memberGroupTypeGroup},
+				},
+			},
+		},
+	}
+	sanitizeConfigMap("genesyscloud_routing_queue_conditional_group_routing", routingConfig, "", exporters, false, nil)
+
+	// Rules are ordered, so the rule with only a missing group is kept with the group's ID
+	rules := routingConfig["rules"].([]interface{})
+	if len(rules) != 2 {
+		t.Fatalf("Expected 2 rules, got %v", rules)
+	}
+	groups := rules[0].(map[string]interface{})["groups"].([]interface{})
+	if len(groups) != 3 {
+		t.Fatalf("Expected 3 groups, got %v", groups)
+	}
+	expectedIDs := map[string]string{
+		memberGroupTypeGroup:      "${genesyscloud_group.support.id}",
+		memberGroupTypeTeam:       "team-1",
+		memberGroupTypeSkillGroup: "skill-group-1",
+	}
+	for _, group := range groups {
+		groupMap := group.(map[string]interface{})
+		if expected := expectedIDs[groupMap["member_group_type"].(string)]; groupMap["member_group_id"] != expected {
+			t.Errorf("Expected member group ID %s, got %v", expected, groupMap["member_group_id"])
+		}
+	}
+
+	secondRule := rules[1].(map[string]interface{})
+	if secondRule["evaluated_queue_id"] != "queue-missing" {
+		t.Errorf("Expected evaluated queue ID to be kept on the second rule, got %v", secondRule["evaluated_queue_id"])
+	}
+	missingGroups := secondRule["groups"].([]interface{})
+	if len(missingGroups) != 1 || missingGroups[0].(map[string]interface{})["member_group_id"] != "group-missing" {
+		t.Errorf("Expected missing group ID to be kept on the second rule, got %v", missingGroups)
+	}
+}
+
+func TestExportManifestReferences(t *testing.T) {
+	exporters := map[string]*ResourceExporter{
+		"genesyscloud_user": {
+			RefAttrs: map[string]*RefAttrSettings{
+				"division_id":             {RefType: "genesyscloud_auth_division"},
+				"routing_skills.skill_id": {RefType: "genesyscloud_routing_skill"},
+			},
+			SanitizedResourceMap: ResourceIDMetaMap{
+				"user-1": {Name: "user_1", OriginalName: "user 1"},
+			},
+		},
+		"genesyscloud_auth_division": {
+			SanitizedResourceMap: ResourceIDMetaMap{"division-1": {Name: "Home"}},
+		},
+		"genesyscloud_routing_skill": {
+			SanitizedResourceMap: ResourceIDMetaMap{"skill-1": {Name: "skill_1"}},
+		},
+	}
+	configMap := jsonMap{
+		"id":          "user-1",
+		"name":        "user 1",
+		"division_id": "division-1",
+		"routing_skills": []interface{}{
+			map[string]interface{}{"skill_id": "skill-1", "proficiency": 1.0},
+			map[string]interface{}{"skill_id": "skill-2", "proficiency": 2.0},
+		},
+	}
+
+	entry := newExportManifestEntry(resourceInfo{ID: "user-1", Name: "user_1", Type: "genesyscloud_user"}, exporters["genesyscloud_user"], configMap)
+	sanitizeConfigMap("genesyscloud_user", configMap, "", exporters, false, entry)
+
+	if entry.Name != "user 1" || entry.Address != "genesyscloud_user.user_1" || entry.DivisionID != "division-1" {
+		t.Errorf("Unexpected manifest entry: %+v", entry)
+	}
+	if len(entry.ResolvedReferences) != 2 {
+		t.Fatalf("Expected 2 resolved references, got %+v", entry.ResolvedReferences)
+	}
+	for _, ref := range entry.ResolvedReferences {
+		if ref.ID == "skill-1" && ref.Address != "genesyscloud_routing_skill.skill_1" {
+			t.Errorf("Unexpected address for skill reference: %s", ref.Address)
+		}
+	}
+	if len(entry.DroppedReferences) != 1 || entry.DroppedReferences[0].ID != "skill-2" ||
+		entry.DroppedReferences[0].Attribute != "routing_skills.skill_id" {
+		t.Errorf("Expected skill-2 to be dropped, got %+v", entry.DroppedReferences)
+	}
+}
+
+func TestExportReferenceFallbacks(t *testing.T) {
+	emailLookups := 0
+	exporters := map[string]*ResourceExporter{
+		"genesyscloud_user": {
+			RefAttrs: map[string]*RefAttrSettings{
+				"manager": {
+					RefType:      "genesyscloud_user",
+					FallbackAttr: "manager_email",
+					FallbackValueFunc: func(_ context.Context, id string, _ interface{}) (string, diag.Diagnostics) {
+						emailLookups++
+						return id + "@example.com", nil
+					},
+				},
+			},
+			SanitizedResourceMap: ResourceIDMetaMap{
+				"user-1":    {Name: "user_1"},
+				"manager-1": {Name: "manager_1"},
+			},
+		},
+	}
+
+	// Exported managers are referenced by ID
+	exportedState := &terraform.InstanceState{ID: "user-1", Attributes: map[string]string{"id": "user-1", "manager": "manager-1"}}
+	if err := setReferenceFallbacks(context.Background(), exporters, exporters["genesyscloud_user"], exportedState, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if exportedState.Attributes["manager"] != "manager-1" || exportedState.Attributes["manager_email"] != "" || emailLookups != 0 {
+		t.Errorf("Expected the exported manager to be referenced by ID, got %v", exportedState.Attributes)
+	}
+
+	// Other managers are referenced by email
+	otherState := &terraform.InstanceState{ID: "user-2", Attributes: map[string]string{"id": "user-2", "manager": "manager-2"}}
+	if err := setReferenceFallbacks(context.Background(), exporters, exporters["genesyscloud_user"], otherState, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if otherState.Attributes["manager"] != "" || otherState.Attributes["manager_email"] != "manager-2@example.com" {
+		t.Errorf("Expected the manager to be referenced by email, got %v", otherState.Attributes)
+	}
+}
+
+func TestExportSchemaZeroValues(t *testing.T) {
+	exporters := map[string]*ResourceExporter{
+		"test_resource": {
+			ResourceSchema: map[string]*schema.Schema{
+				"name":        {Type: schema.TypeString, Required: true},
+				"description": {Type: schema.TypeString, Optional: true},
+				"weight":      {Type: schema.TypeInt, Optional: true, ValidateFunc: validation.IntBetween(0, 10)},
+				"timeout":     {Type: schema.TypeInt, Optional: true, ValidateFunc: validation.IntBetween(1, 10)},
+				"retries":     {Type: schema.TypeInt, Optional: true, Default: 3},
+				"interval":    {Type: schema.TypeInt, Optional: true, Default: 0},
+				"version":     {Type: schema.TypeInt, Computed: true},
+				"members": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"user_id":  {Type: schema.TypeString, Required: true},
+							"ring_num": {Type: schema.TypeInt, Optional: true, Default: 1},
+							"state":    {Type: schema.TypeString, Computed: true},
+						},
+					},
+				},
+			},
+		},
+	}
+	configMap := jsonMap{
+		"name":        "",
+		"description": "",
+		"weight":      float64(0),
+		"timeout":     float64(0),
+		"retries":     float64(0),
+		"interval":    float64(0),
+		"version":     float64(5),
+		"members": []interface{}{
+			map[string]interface{}{"user_id": "user-1", "ring_num": float64(0), "state": "active"},
+		},
+	}
+
+	sanitizeConfigMap("test_resource", configMap, "", exporters, true, nil)
+
+	expectedValues := map[string]interface{}{
+		"name":        "",         // Required attributes are always kept
+		"description": nil,        // Empty optional strings are unset
+		"weight":      float64(0), // Valid zero with no default is kept
+		"timeout":     nil,        // Invalid zero is removed
+		"retries":     float64(0), // Zero differs from the default and must be explicit
+		"interval":    nil,        // Zero matches the default
+		"version":     nil,        // Computed-only attributes cannot be set
+	}
+	for attr, expected := range expectedValues {
+		if configMap[attr] != expected {
+			t.Errorf("Expected %s to be %v, got %v", attr, expected, configMap[attr])
+		}
+	}
+
+	member := configMap["members"].([]interface{})[0].(map[string]interface{})
+	if member["ring_num"] != float64(0) || member["state"] != nil {
+		t.Errorf("Unexpected nested member config: %v", member)
+	}
+}
+
+func TestExportVerifyDifferences(t *testing.T) {
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"test_queue": {
+				Schema: map[string]*schema.Schema{
+					"name":        {Type: schema.TypeString, Required: true},
+					"description": {Type: schema.TypeString, Optional: true},
+					"skill_id":    {Type: schema.TypeString, Optional: true},
+					"timeout":     {Type: schema.TypeInt, Optional: true},
+				},
+			},
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "queue-1",
+		Attributes: map[string]string{
+			"id":          "queue-1",
+			"name":        "Queue ${1}",
+			"description": "Sales",
+			"skill_id":    "skill-1",
+			"timeout":     "30",
+		},
+	}
+	resources := []resourceInfo{{State: state, ID: "queue-1", Name: "queue_1", Type: "test_queue"}}
+	manifest := map[string]*exportManifestEntry{
+		"test_skill.skill_1": {ID: "skill-1"},
+	}
+
+	configMaps := map[string]map[string]jsonMap{
+		"test_queue": {
+			"queue_1": {
+				"name":        "Queue $${1}",
+				"description": "Sales",
+				"skill_id":    "${test_skill.skill_1.id}",
+				"timeout":     float64(30),
+			},
+		},
+	}
+	differences, err := verifyExportedResources(context.Background(), provider, resources, configMaps, manifest, nil)
+	if err != nil {
+		t.Fatalf("Unexpected verify error: %v", err)
+	}
+	if len(differences) != 0 {
+		t.Errorf("Expected no differences, got %v", differences)
+	}
+
+	// Simulate a dropped reference and a removed zero value
+	configMaps["test_queue"]["queue_1"]["skill_id"] = nil
+	configMaps["test_queue"]["queue_1"]["timeout"] = nil
+	differences, err = verifyExportedResources(context.Background(), provider, resources, configMaps, manifest, nil)
+	if err != nil {
+		t.Fatalf("Unexpected verify error: %v", err)
+	}
+	if len(differences) != 2 ||
+		!strings.HasPrefix(differences[0], "test_queue.queue_1.skill_id:") ||
+		!strings.HasPrefix(differences[1], "test_queue.queue_1.timeout:") {
+		t.Errorf("Expected skill_id and timeout differences, got %v", differences)
+	}
+}
+
 func isIgnoredReferenceCycle(cycle []string) bool {
 	// Some cycles cannot be broken with a schema change and must be dealt with in the config
 	// These cycles can be ignored by this test
@@ -535,6 +1280,20 @@ func testVerifyExportsDestroyed(state *terraform.State) error {
 	if !os.IsNotExist(err) {
 		return fmt.Errorf("Failed to delete state file %s", statePath)
 	}
+
+	// Check manifest file deleted
+	manifestPath := filepath.Join(exportTestDir, defaultTfManifestFile)
+	_, err = os.Stat(manifestPath)
+	if !os.IsNotExist(err) {
+		return fmt.Errorf("Failed to delete manifest file %s", manifestPath)
+	}
+
+	// Check exported prompt files deleted
+	promptDir := filepath.Join(exportTestDir, userPromptExportDir)
+	_, err = os.Stat(promptDir)
+	if !os.IsNotExist(err) {
+		return fmt.Errorf("Failed to delete prompt directory %s", promptDir)
+	}
 	return nil
 }
 
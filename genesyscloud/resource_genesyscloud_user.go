@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
-	"github.com/nyaruka/phonenumbers"
 )
 
 var (
@@ -21,10 +20,11 @@ var (
 	phoneNumberResource = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"number": {
-				Description:      "Phone number. Defaults to US country code.",
+				Description:      "Phone number. Defaults to US country code. Numbers are stored in E.164 format.",
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateDiagFunc: validatePhoneNumber,
+				DiffSuppressFunc: comparePhoneNumbers,
 			},
 			"media_type": {
 				Description:  "Media type of phone number (SMS | PHONE).",
@@ -107,6 +107,32 @@ var (
 			},
 		},
 	}
+	userCallRouteResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"targets": {
+				Description: "Targets called at the same time when this route is executed.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "Type of target (PHONE | STATION).",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PHONE", "STATION"}, false),
+						},
+						"value": {
+							Description:      "Station ID or phone number. Phone numbers default to US country code and are stored in E.164 format.",
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: compareCallTargets,
+						},
+					},
+				},
+			},
+		},
+	}
 )
 
 func getAllUsers(_ context.Context, sdkConfig *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics) {
@@ -139,33 +165,43 @@ func userExporter() *ResourceExporter {
 	return &ResourceExporter{
 		GetResourcesFunc: getAllWithPooledClient(getAllUsers),
 		RefAttrs: map[string]*RefAttrSettings{
-			"manager":                       {RefType: "genesyscloud_user"},
-			"division_id":                   {RefType: "genesyscloud_auth_division"},
-			"routing_skills.skill_id":       {RefType: "genesyscloud_routing_skill"},
-			"routing_languages.language_id": {RefType: "genesyscloud_routing_language"},
-			"locations.location_id":         {RefType: "genesyscloud_location"},
+			"manager": {
+				RefType:           "genesyscloud_user",
+				FallbackAttr:      "manager_email",
+				FallbackValueFunc: getUserEmail,
+			},
+			"division_id":                                                   {RefType: "genesyscloud_auth_division"},
+			"routing_skills.skill_id":                                       {RefType: "genesyscloud_routing_skill"},
+			"routing_languages.language_id":                                 {RefType: "genesyscloud_routing_language"},
+			"locations.location_id":                                         {RefType: "genesyscloud_location"},
+			"routing_utilization.label_utilizations.label_id":               {RefType: "genesyscloud_routing_utilization_label"},
+			"routing_utilization.label_utilizations.interrupting_label_ids": {RefType: "genesyscloud_routing_utilization_label"},
 		},
 		RemoveIfMissing: map[string][]string{
 			"routing_skills":    {"skill_id"},
 			"routing_languages": {"language_id"},
 			"locations":         {"location_id"},
 		},
-		AllowZeroValues: []string{"routing_skills.proficiency" ,"routing_languages.proficiency"},
 	}
 }
 
 func resourceUser() *schema.Resource {
-	return &schema.Resource{
+	userResource := &schema.Resource{
 		Description: "Genesys Cloud User",
 
 		CreateContext: createWithPooledClient(createUser),
 		ReadContext:   readWithPooledClient(readUser),
 		UpdateContext: updateWithPooledClient(updateUser),
 		DeleteContext: deleteWithPooledClient(deleteUser),
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		SchemaVersion: 1,
+		Importer:      importByName(dataSourceUser, "email"),
+		CustomizeDiff: validateReferencesDiff(map[string]referenceValidator{
+			"manager":                       userReference,
+			"division_id":                   divisionReference,
+			"routing_skills.skill_id":       skillReference,
+			"routing_languages.language_id": languageReference,
+			"locations.location_id":         locationReference,
+		}),
+		SchemaVersion: 2,
 		Schema: map[string]*schema.Schema{
 			"email": {
 				Description: "User's primary email and username.",
@@ -207,9 +243,17 @@ func resourceUser() *schema.Resource {
 				Optional:    true,
 			},
 			"manager": {
-				Description: "User ID of this user's manager.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:   "User ID of this user's manager.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"manager_email"},
+			},
+			"manager_email": {
+				Description:      "Email of this user's manager. Can be used instead of `manager` when the manager is not managed by Terraform or is created in the same apply. The manager is found by email when the user is created or updated.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"manager"},
+				DiffSuppressFunc: compareEmails,
 			},
 			"acd_auto_answer": {
 				Description: "Enable ACD auto-answer.",
@@ -379,11 +423,95 @@ func resourceUser() *schema.Resource {
 							ConfigMode:  schema.SchemaConfigModeAttr,
 							Elem:        utilizationSettingsResource,
 						},
+						"label_utilizations": {
+							Description: "Label utilization settings. Conversations with a label use the label's capacity instead of their media type's capacity. If not set, this resource will not manage label utilizations.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							ConfigMode:  schema.SchemaConfigModeAttr,
+							Elem:        labelUtilizationResource,
+						},
+					},
+				},
+			},
+			"voicemail_userpolicies": {
+				Description: "User's voicemail policy. If not set, this resource will not manage the user's voicemail policy.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alert_timeout_seconds": {
+							Description:  "Number of seconds to ring the user's phone before a call is transferred to voicemail.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"send_email_notifications": {
+							Description: "Whether email notifications are sent to the user when a new voicemail is received.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"pin": {
+							Description: "User's voicemail PIN. If specified, the PIN is reset when this value changes. The PIN is never read from Genesys Cloud.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"call_forwarding": {
+				Description: "User's call forwarding settings. If not set, this resource will not manage the user's call forwarding.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Description: "Whether calls are forwarded.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"calls": {
+							Description: "Ordered list of routes executed when call forwarding is enabled.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        userCallRouteResource,
+						},
+						"voicemail": {
+							Description:  "Voicemail used for forwarded calls that are not answered (PURECLOUD | LASTCALL | NONE).",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PURECLOUD", "LASTCALL", "NONE"}, false),
+						},
 					},
 				},
 			},
 		},
 	}
+
+	// The shape of the version 1 schema is unchanged
+	userResource.StateUpgraders = []schema.StateUpgrader{
+		newStateUpgrader(1, userResource, upgradeUserStateV1),
+	}
+	return userResource
+}
+
+// Version 2 stores phone numbers in E.164 format
+func upgradeUserStateV1(stateMap map[string]interface{}) error {
+	return upgradeNestedStateMaps(stateMap, []string{"addresses", "phone_numbers"}, func(phoneMap map[string]interface{}) error {
+		if number, ok := phoneMap["number"].(string); ok {
+			phoneMap["number"] = formatE164PhoneNumber(number)
+		}
+		return nil
+	})
 }
 
 func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -394,11 +522,15 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	divisionID := d.Get("division_id").(string)
 	department := d.Get("department").(string)
 	title := d.Get("title").(string)
-	manager := d.Get("manager").(string)
 	acdAutoAnswer := d.Get("acd_auto_answer").(bool)
 
 	sdkConfig := meta.(*providerMeta).ClientConfig
-	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	usersAPI := meta.(*providerMeta).usersProxy()
+
+	manager, diagErr := getUserManagerID(ctx, d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
 
 	addresses, addrErr := buildSdkAddresses(d)
 	if addrErr != nil {
@@ -444,6 +576,7 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	// Set attributes that can only be modified in a patch
 	if d.HasChanges(
 		"manager",
+		"manager_email",
 		"locations",
 		"acd_auto_answer",
 		"profile_skills",
@@ -463,7 +596,7 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 	}
 
-	diagErr := updateUserSkills(d, usersAPI)
+	diagErr = updateUserSkills(d, usersAPI)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -478,7 +611,17 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diagErr
 	}
 
-	diagErr = updateUserRoutingUtilization(d, usersAPI)
+	diagErr = updateUserRoutingUtilization(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateUserVoicemailPolicies(d, sdkConfig)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateUserCallForwarding(d, usersAPI)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -489,7 +632,11 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*providerMeta).ClientConfig
-	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	usersAPI := meta.(*providerMeta).usersProxy()
+
+	// Voicemail policies and call forwarding are only read when they are managed, or when a user is imported or exported.
+	// Users being imported or exported have no state yet.
+	readAllSettings := d.Get("email").(string) == ""
 
 	log.Printf("Reading user %s", d.Id())
 	return withRetriesForRead(ctx, 30*time.Second, d, func() *resource.RetryError {
@@ -528,10 +675,8 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 			d.Set("title", nil)
 		}
 
-		if currentUser.Manager != nil {
-			d.Set("manager", *(*currentUser.Manager).Id)
-		} else {
-			d.Set("manager", nil)
+		if diagErr := readUserManager(ctx, d, meta, currentUser.Manager); diagErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("%v", diagErr))
 		}
 
 		if currentUser.AcdAutoAnswer != nil {
@@ -548,10 +693,22 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		d.Set("certifications", flattenUserCertifications(currentUser.Certifications))
 		d.Set("employer_info", flattenUserEmployerInfo(currentUser.EmployerInfo))
 
-		if diagErr := readUserRoutingUtilization(d, usersAPI); diagErr != nil {
+		if diagErr := readUserRoutingUtilization(d, meta.(*providerMeta).routingProxy()); diagErr != nil {
 			return resource.NonRetryableError(fmt.Errorf("%v", getErr))
 		}
 
+		if readAllSettings || len(d.Get("voicemail_userpolicies").([]interface{})) > 0 {
+			if diagErr := readUserVoicemailPolicies(d, sdkConfig); diagErr != nil {
+				return resource.NonRetryableError(fmt.Errorf("%v", diagErr))
+			}
+		}
+
+		if readAllSettings || len(d.Get("call_forwarding").([]interface{})) > 0 {
+			if diagErr := readUserCallForwarding(d, usersAPI); diagErr != nil {
+				return resource.NonRetryableError(fmt.Errorf("%v", diagErr))
+			}
+		}
+
 		log.Printf("Read user %s %s", d.Id(), *currentUser.Email)
 		return nil
 	})
@@ -563,11 +720,15 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	state := d.Get("state").(string)
 	department := d.Get("department").(string)
 	title := d.Get("title").(string)
-	manager := d.Get("manager").(string)
 	acdAutoAnswer := d.Get("acd_auto_answer").(bool)
 
 	sdkConfig := meta.(*providerMeta).ClientConfig
-	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	usersAPI := meta.(*providerMeta).usersProxy()
+
+	manager, diagErr := getUserManagerID(ctx, d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
 
 	addresses, err := buildSdkAddresses(d)
 	if err != nil {
@@ -603,7 +764,7 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return patchErr
 	}
 
-	diagErr := updateObjectDivision(d, "USER", sdkConfig)
+	diagErr = updateObjectDivision(d, "USER", sdkConfig)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -623,7 +784,17 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diagErr
 	}
 
-	diagErr = updateUserRoutingUtilization(d, usersAPI)
+	diagErr = updateUserRoutingUtilization(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateUserVoicemailPolicies(d, sdkConfig)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateUserCallForwarding(d, usersAPI)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -636,8 +807,7 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 func deleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	email := d.Get("email").(string)
 
-	sdkConfig := meta.(*providerMeta).ClientConfig
-	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	usersAPI := meta.(*providerMeta).usersProxy()
 
 	log.Printf("Deleting user %s", email)
 	err := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
@@ -667,11 +837,11 @@ func deleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	})
 }
 
-func patchUser(id string, update platformclientv2.Updateuser, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+func patchUser(id string, update platformclientv2.Updateuser, usersAPI usersProxy) diag.Diagnostics {
 	return patchUserWithState(id, "", update, usersAPI)
 }
 
-func patchUserWithState(id string, state string, update platformclientv2.Updateuser, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+func patchUserWithState(id string, state string, update platformclientv2.Updateuser, usersAPI usersProxy) diag.Diagnostics {
 	return retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		currentUser, _, getErr := usersAPI.GetUser(id, nil, "", state)
 		if getErr != nil {
@@ -687,7 +857,7 @@ func patchUserWithState(id string, state string, update platformclientv2.Updateu
 	})
 }
 
-func getDeletedUserId(email string, usersAPI *platformclientv2.UsersApi) (*string, diag.Diagnostics) {
+func getDeletedUserId(email string, usersAPI usersProxy) (*string, diag.Diagnostics) {
 	exactType := "EXACT"
 	results, _, getErr := usersAPI.PostUsersSearch(platformclientv2.Usersearchrequest{
 		Query: &[]platformclientv2.Usersearchcriteria{
@@ -713,10 +883,14 @@ func getDeletedUserId(email string, usersAPI *platformclientv2.UsersApi) (*strin
 	return nil, nil
 }
 
-func restoreDeletedUser(ctx context.Context, d *schema.ResourceData, meta interface{}, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+func restoreDeletedUser(ctx context.Context, d *schema.ResourceData, meta interface{}, usersAPI usersProxy) diag.Diagnostics {
 	email := d.Get("email").(string)
 	state := d.Get("state").(string)
 
+	if err := checkWriteAllowed(meta, "restore deleted user"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("Restoring deleted user %s", email)
 	patchErr := patchUserWithState(d.Id(), "deleted", platformclientv2.Updateuser{
 		State: &state,
@@ -727,6 +901,63 @@ func restoreDeletedUser(ctx context.Context, d *schema.ResourceData, meta interf
 	return updateUser(ctx, d, meta)
 }
 
+// Managers created in the same apply may take time to be created and indexed
+const userManagerSearchTimeout = 2 * time.Minute
+
+// getUserManagerID returns the manager's ID from manager, or finds it by manager_email
+func getUserManagerID(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, diag.Diagnostics) {
+	managerEmail := d.Get("manager_email").(string)
+	if managerEmail == "" {
+		return d.Get("manager").(string), nil
+	}
+
+	log.Printf("Searching for manager %s", managerEmail)
+	managerID, diagErr := searchUserID(ctx, meta.(*providerMeta).usersProxy(), "email", managerEmail, userManagerSearchTimeout)
+	if diagErr != nil {
+		return "", diag.Errorf("Failed to find manager %s: %v", managerEmail, diagErr)
+	}
+	return managerID, nil
+}
+
+// readUserManager sets manager_email instead of manager if the user's manager is configured by email
+func readUserManager(ctx context.Context, d *schema.ResourceData, meta interface{}, manager **platformclientv2.User) diag.Diagnostics {
+	if manager == nil || (*manager).Id == nil {
+		d.Set("manager", nil)
+		d.Set("manager_email", nil)
+		return nil
+	}
+	managerID := *(*manager).Id
+
+	if d.Get("manager_email").(string) == "" {
+		d.Set("manager", managerID)
+		return nil
+	}
+
+	managerEmail, diagErr := getUserEmail(ctx, managerID, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+	d.Set("manager", nil)
+	d.Set("manager_email", managerEmail)
+	return nil
+}
+
+// Emails are not case-sensitive
+func compareEmails(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+func getUserEmail(_ context.Context, id string, meta interface{}) (string, diag.Diagnostics) {
+	user, _, err := meta.(*providerMeta).usersProxy().GetUser(id, nil, "", "")
+	if err != nil {
+		return "", diag.Errorf("Failed to read user %s: %s", id, err)
+	}
+	if user.Email == nil {
+		return "", nil
+	}
+	return *user.Email, nil
+}
+
 func phoneNumberHash(val interface{}) int {
 	// Copy map to avoid modifying state
 	phoneMap := make(map[string]interface{})
@@ -735,10 +966,7 @@ func phoneNumberHash(val interface{}) int {
 	}
 	if num, ok := phoneMap["number"]; ok {
 		// Attempt to format phone numbers before hashing
-		number, err := phonenumbers.Parse(num.(string), "US")
-		if err == nil {
-			phoneMap["number"] = phonenumbers.Format(number, phonenumbers.E164)
-		}
+		phoneMap["number"] = formatE164PhoneNumber(num.(string))
 	}
 	return schema.HashResource(phoneNumberResource)(phoneMap)
 }
@@ -877,10 +1105,10 @@ func flattenUserAddresses(addresses *[]platformclientv2.Contact) []interface{} {
 
 				// Strip off any parentheses from phone numbers
 				if address.Address != nil {
-					phoneNumber["number"] = strings.Trim(*address.Address, "()")
+					phoneNumber["number"] = formatE164PhoneNumber(strings.Trim(*address.Address, "()"))
 				} else if address.Display != nil {
 					// Some numbers are only returned in Display
-					phoneNumber["number"] = strings.Trim(*address.Display, "()")
+					phoneNumber["number"] = formatE164PhoneNumber(strings.Trim(*address.Display, "()"))
 				}
 
 				if address.Extension != nil {
@@ -939,8 +1167,8 @@ func flattenUserEmployerInfo(empInfo *platformclientv2.Employerinfo) []interface
 	}}
 }
 
-func readUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
-	settings, resp, getErr := usersAPI.GetRoutingUserUtilization(d.Id())
+func readUserRoutingUtilization(d *schema.ResourceData, routingAPI routingProxy) diag.Diagnostics {
+	settings, resp, getErr := routingAPI.GetRoutingUserUtilizationWithLabels(d.Id())
 	if getErr != nil {
 		if isStatus404(resp) {
 			d.SetId("") // User doesn't exist
@@ -960,6 +1188,7 @@ func readUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclient
 					allSettings[schemaType] = flattenUtilizationSetting(mediaSettings)
 				}
 			}
+			allSettings["label_utilizations"] = flattenLabelUtilizations(settings.LabelUtilizations)
 			d.Set("routing_utilization", []interface{}{allSettings})
 		}
 	} else {
@@ -969,7 +1198,172 @@ func readUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclient
 	return nil
 }
 
-func updateUserSkills(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+func readUserVoicemailPolicies(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	voicemailAPI := platformclientv2.NewVoicemailApiWithConfig(sdkConfig)
+
+	policy, resp, getErr := voicemailAPI.GetVoicemailUserpolicy(d.Id())
+	if getErr != nil {
+		if isStatus404(resp) {
+			d.Set("voicemail_userpolicies", nil)
+			return nil
+		}
+		if isStatus403(resp) {
+			// Reading the policy needs voicemail permissions, so keep the current state without them
+			log.Printf("Not permitted to read voicemail policy for user %s", d.Id())
+			return nil
+		}
+		return diag.Errorf("Failed to read voicemail policy for user %s: %s", d.Id(), getErr)
+	}
+
+	policyMap := map[string]interface{}{
+		// The PIN is never returned, so keep the configured value
+		"pin": d.Get("voicemail_userpolicies.0.pin"),
+	}
+	if policy.AlertTimeoutSeconds != nil {
+		policyMap["alert_timeout_seconds"] = *policy.AlertTimeoutSeconds
+	}
+	if policy.SendEmailNotifications != nil {
+		policyMap["send_email_notifications"] = *policy.SendEmailNotifications
+	}
+	d.Set("voicemail_userpolicies", []interface{}{policyMap})
+	return nil
+}
+
+func updateUserVoicemailPolicies(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	if !d.HasChange("voicemail_userpolicies") {
+		return nil
+	}
+	policyConfig := d.Get("voicemail_userpolicies").([]interface{})
+	if len(policyConfig) == 0 || policyConfig[0] == nil {
+		return nil
+	}
+
+	policyMap := policyConfig[0].(map[string]interface{})
+	sendEmailNotifications := policyMap["send_email_notifications"].(bool)
+	policy := platformclientv2.Voicemailuserpolicy{
+		SendEmailNotifications: &sendEmailNotifications,
+	}
+	if alertTimeout := policyMap["alert_timeout_seconds"].(int); alertTimeout > 0 {
+		policy.AlertTimeoutSeconds = &alertTimeout
+	}
+	if pin := policyMap["pin"].(string); pin != "" && d.HasChange("voicemail_userpolicies.0.pin") {
+		policy.Pin = &pin
+	}
+
+	log.Printf("Updating voicemail policy for user %s", d.Id())
+	voicemailAPI := platformclientv2.NewVoicemailApiWithConfig(sdkConfig)
+	_, _, err := voicemailAPI.PatchVoicemailUserpolicy(d.Id(), policy)
+	if err != nil {
+		return diag.Errorf("Failed to update voicemail policy for user %s: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func readUserCallForwarding(d *schema.ResourceData, usersAPI usersProxy) diag.Diagnostics {
+	forwarding, resp, getErr := usersAPI.GetUserCallforwarding(d.Id())
+	if getErr != nil {
+		if isStatus404(resp) {
+			d.Set("call_forwarding", nil)
+			return nil
+		}
+		if isStatus403(resp) {
+			// Keep the current state when the client is not permitted to read call forwarding
+			log.Printf("Not permitted to read call forwarding for user %s", d.Id())
+			return nil
+		}
+		return diag.Errorf("Failed to read call forwarding for user %s: %s", d.Id(), getErr)
+	}
+	d.Set("call_forwarding", flattenUserCallForwarding(forwarding))
+	return nil
+}
+
+func updateUserCallForwarding(d *schema.ResourceData, usersAPI usersProxy) diag.Diagnostics {
+	if !d.HasChange("call_forwarding") {
+		return nil
+	}
+	forwardingConfig := d.Get("call_forwarding").([]interface{})
+	if len(forwardingConfig) == 0 || forwardingConfig[0] == nil {
+		return nil
+	}
+
+	log.Printf("Updating call forwarding for user %s", d.Id())
+	_, _, err := usersAPI.PutUserCallforwarding(d.Id(), buildSdkCallForwarding(forwardingConfig[0].(map[string]interface{})))
+	if err != nil {
+		return diag.Errorf("Failed to update call forwarding for user %s: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func buildSdkCallForwarding(forwardingMap map[string]interface{}) platformclientv2.Callforwarding {
+	enabled := forwardingMap["enabled"].(bool)
+	calls := make([]platformclientv2.Callroute, 0)
+	for _, callConfig := range forwardingMap["calls"].([]interface{}) {
+		targets := make([]platformclientv2.Calltarget, 0)
+		for _, targetConfig := range callConfig.(map[string]interface{})["targets"].([]interface{}) {
+			targetMap := targetConfig.(map[string]interface{})
+			targetType := targetMap["type"].(string)
+			value := targetMap["value"].(string)
+			if targetType == "PHONE" {
+				value = formatE164PhoneNumber(value)
+			}
+			targets = append(targets, platformclientv2.Calltarget{
+				VarType: &targetType,
+				Value:   &value,
+			})
+		}
+		calls = append(calls, platformclientv2.Callroute{Targets: &targets})
+	}
+
+	forwarding := platformclientv2.Callforwarding{
+		Enabled: &enabled,
+		Calls:   &calls,
+	}
+	if voicemail := forwardingMap["voicemail"].(string); voicemail != "" {
+		forwarding.Voicemail = &voicemail
+	}
+	return forwarding
+}
+
+func flattenUserCallForwarding(forwarding *platformclientv2.Callforwarding) []interface{} {
+	forwardingMap := map[string]interface{}{
+		"enabled": forwarding.Enabled != nil && *forwarding.Enabled,
+	}
+	if forwarding.Voicemail != nil {
+		forwardingMap["voicemail"] = *forwarding.Voicemail
+	}
+
+	var calls []interface{}
+	if forwarding.Calls != nil {
+		for _, call := range *forwarding.Calls {
+			var targets []interface{}
+			if call.Targets != nil {
+				for _, target := range *call.Targets {
+					targetMap := make(map[string]interface{})
+					if target.VarType != nil {
+						targetMap["type"] = *target.VarType
+					}
+					if target.Value != nil {
+						targetMap["value"] = *target.Value
+					}
+					targets = append(targets, targetMap)
+				}
+			}
+			calls = append(calls, map[string]interface{}{"targets": targets})
+		}
+	}
+	forwardingMap["calls"] = calls
+	return []interface{}{forwardingMap}
+}
+
+// Phone number targets are compared in E.164 format. Station IDs must match exactly.
+func compareCallTargets(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get(strings.TrimSuffix(k, "value")+"type") == "PHONE" {
+		return comparePhoneNumbers(k, old, new, d)
+	}
+	return old == new
+}
+
+func updateUserSkills(d *schema.ResourceData, usersAPI usersProxy) diag.Diagnostics {
 	if d.HasChange("routing_skills") {
 		if skillsConfig := d.Get("routing_skills"); skillsConfig != nil {
 			sdkSkills := make([]platformclientv2.Userroutingskillpost, 0)
@@ -998,7 +1392,7 @@ func updateUserSkills(d *schema.ResourceData, usersAPI *platformclientv2.UsersAp
 	return nil
 }
 
-func updateUserLanguages(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+func updateUserLanguages(d *schema.ResourceData, usersAPI usersProxy) diag.Diagnostics {
 	if d.HasChange("routing_languages") {
 		if languages := d.Get("routing_languages"); languages != nil {
 			log.Printf("Updating languages for user %s", d.Get("email"))
@@ -1061,7 +1455,7 @@ func updateUserLanguages(d *schema.ResourceData, usersAPI *platformclientv2.User
 	return nil
 }
 
-func getUserRoutingLanguages(userID string, api *platformclientv2.UsersApi) ([]platformclientv2.Userroutinglanguage, diag.Diagnostics) {
+func getUserRoutingLanguages(userID string, api usersProxy) ([]platformclientv2.Userroutinglanguage, diag.Diagnostics) {
 	const maxPageSize = 50
 
 	var sdkLanguages []platformclientv2.Userroutinglanguage
@@ -1083,7 +1477,7 @@ func updateUserRoutingLanguages(
 	userID string,
 	langsToUpdate []string,
 	langProfs map[string]int,
-	api *platformclientv2.UsersApi) diag.Diagnostics {
+	api usersProxy) diag.Diagnostics {
 	// Bulk API restricts language adds to 50 per call
 	const maxBatchSize = 50
 	for i := 0; i < len(langsToUpdate); i += maxBatchSize {
@@ -1117,7 +1511,7 @@ func updateUserRoutingLanguages(
 	return nil
 }
 
-func updateUserProfileSkills(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+func updateUserProfileSkills(d *schema.ResourceData, usersAPI usersProxy) diag.Diagnostics {
 	if d.HasChange("profile_skills") {
 		if profileSkills := d.Get("profile_skills"); profileSkills != nil {
 			profileSkills := setToStringList(profileSkills.(*schema.Set))
@@ -1136,7 +1530,7 @@ func updateUserProfileSkills(d *schema.ResourceData, usersAPI *platformclientv2.
 	return nil
 }
 
-func updateUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+func updateUserRoutingUtilization(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("routing_utilization") {
 		if utilConfig := d.Get("routing_utilization").([]interface{}); utilConfig != nil {
 			if len(utilConfig) > 0 { // Specified but empty utilization list will reset to org-wide defaults
@@ -1147,15 +1541,18 @@ func updateUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclie
 						sdkSettings[sdkType] = buildSdkMediaUtilization(mediaSettings.([]interface{}))
 					}
 				}
+				labelSettings, _ := allSettings["label_utilizations"].(*schema.Set)
 				// Update settings
-				_, _, err := usersAPI.PutRoutingUserUtilization(d.Id(), platformclientv2.Utilization{
-					Utilization: &sdkSettings,
+				_, err := meta.(*providerMeta).routingProxy().PutRoutingUserUtilizationWithLabels(d.Id(), labelledUtilization{
+					Utilization:       &sdkSettings,
+					LabelUtilizations: buildLabelUtilizations(labelSettings),
 				})
 				if err != nil {
 					return diag.Errorf("Failed to update Routing Utilization for user %s: %s", d.Id(), err)
 				}
 			} else {
 				// Reset to org-wide defaults
+				usersAPI := meta.(*providerMeta).usersProxy()
 				_, err := usersAPI.DeleteRoutingUserUtilization(d.Id())
 				if err != nil {
 					return diag.Errorf("Failed to delete Routing Utilization for user %s: %s", d.Id(), err)
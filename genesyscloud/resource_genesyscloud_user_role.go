@@ -0,0 +1,109 @@
+package genesyscloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+func resourceUserRole() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud User Role maintains a single role assignment for a user. Unlike genesyscloud_user_roles, role grants not managed by this resource are left unchanged, so it should not be used for the same user as genesyscloud_user_roles.`,
+
+		CreateContext: createWithPooledClient(createUserRole),
+		ReadContext:   readWithPooledClient(readUserRole),
+		UpdateContext: updateWithPooledClient(updateUserRole),
+		DeleteContext: deleteWithPooledClient(deleteUserRole),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "User ID that will be managed by this resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"role_id": {
+				Description: "Role ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"division_ids": {
+				Description: "Division IDs the role is granted in. If not set, the home division will be used. '*' may be set for all divisions.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func createUserRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	roleID := d.Get("role_id").(string)
+	d.SetId(createSubjectRoleID(userID, roleID))
+	return updateUserRole(ctx, d, meta)
+}
+
+func readUserRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userID, roleID := splitSubjectRoleID(d.Id())
+	if userID == "" {
+		return diag.Errorf("Invalid user role ID %s. Expected <user ID>/<role ID>", d.Id())
+	}
+
+	sdkConfig := meta.(*providerMeta).ClientConfig
+	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+
+	log.Printf("Reading role %s for user %s", roleID, userID)
+
+	d.Set("user_id", userID)
+	d.Set("role_id", roleID)
+
+	diagErr := readSubjectRole(ctx, d, userID, roleID, authAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Read role %s for user %s", roleID, userID)
+	return nil
+}
+
+func updateUserRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userID, roleID := splitSubjectRoleID(d.Id())
+
+	sdkConfig := meta.(*providerMeta).ClientConfig
+	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+
+	log.Printf("Updating role %s for user %s", roleID, userID)
+	diagErr := updateSubjectRole(d, userID, roleID, authAPI, "PC_USER")
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated role %s for user %s", roleID, userID)
+	return readUserRole(ctx, d, meta)
+}
+
+func deleteUserRole(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userID, roleID := splitSubjectRoleID(d.Id())
+
+	sdkConfig := meta.(*providerMeta).ClientConfig
+	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+
+	// Does not delete users or roles. Only the grants managed by this resource are removed.
+	log.Printf("Removing role %s for user %s", roleID, userID)
+	diagErr := deleteSubjectRole(d, userID, roleID, authAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Removed role %s for user %s", roleID, userID)
+	return nil
+}
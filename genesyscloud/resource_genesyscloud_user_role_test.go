@@ -0,0 +1,230 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+func TestAccResourceUserRole(t *testing.T) {
+	var (
+		empRoleDataSrc   = "employee-role"
+		empRoleName      = "employee"
+		userRoleResource = "test-user-role"
+		userResource1    = "test-user"
+		email1           = "terraform-" + uuid.NewString() + "@example.com"
+		userName1        = "Role Terraform"
+		roleResource1    = "test-role-1"
+		roleName1        = "Terraform User Role Test1" + uuid.NewString()
+		roleDesc         = "Terraform user role test"
+		divResource      = "test-division"
+		divName          = "terraform-" + uuid.NewString()
+	)
+
+	// New users are automatically granted the employee role, which is not managed by the user role resource
+	config := generateBasicUserResource(
+		userResource1,
+		email1,
+		userName1,
+	) + generateAuthRoleResource(
+		roleResource1,
+		roleName1,
+		roleDesc,
+	) + generateDefaultAuthRoleDataSource(
+		empRoleDataSrc,
+		strconv.Quote(empRoleName),
+	) + generateAuthDivisionBasic(divResource, divName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Grant the role in the home division
+				Config: config + generateUserRole(
+					userRoleResource,
+					"genesyscloud_user."+userResource1+".id",
+					"genesyscloud_auth_role."+roleResource1+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user_role."+userRoleResource, "division_ids.#", "1"),
+					testVerifySubjectRoleGranted("genesyscloud_user."+userResource1, "genesyscloud_auth_role."+roleResource1),
+					testVerifySubjectRoleGranted("genesyscloud_user."+userResource1, "data.genesyscloud_auth_role."+empRoleDataSrc),
+				),
+			},
+			{
+				// Move the role to another division
+				Config: config + generateUserRole(
+					userRoleResource,
+					"genesyscloud_user."+userResource1+".id",
+					"genesyscloud_auth_role."+roleResource1+".id",
+					"genesyscloud_auth_division."+divResource+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user_role."+userRoleResource, "division_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("genesyscloud_user_role."+userRoleResource, "division_ids.*", "genesyscloud_auth_division."+divResource, "id"),
+					testVerifySubjectRoleGranted("genesyscloud_user."+userResource1, "data.genesyscloud_auth_role."+empRoleDataSrc),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_user_role." + userRoleResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Remove the user role resource. Other roles of the user are not removed.
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testVerifySubjectRoleGranted("genesyscloud_user."+userResource1, "data.genesyscloud_auth_role."+empRoleDataSrc),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitUserRole(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	ctx := context.Background()
+	roleResource := resourceUserRole()
+
+	userID := fakeAPI.create("/api/v2/users", map[string]interface{}{"name": "User", "email": "user@example.com"})["id"].(string)
+	managedRole := fakeAPI.create("/api/v2/authorization/roles", map[string]interface{}{"name": "Managed"})["id"].(string)
+	otherRole := fakeAPI.create("/api/v2/authorization/roles", map[string]interface{}{"name": "Other"})["id"].(string)
+	homeDiv := fakeAPI.homeDivisionID
+	div1 := fakeAPI.create("/api/v2/authorization/divisions", map[string]interface{}{"name": "Division 1"})["id"].(string)
+	div2 := fakeAPI.create("/api/v2/authorization/divisions", map[string]interface{}{"name": "Division 2"})["id"].(string)
+
+	// Grants made outside of Terraform, e.g. by SCIM
+	grantsPath := "/api/v2/authorization/subjects/" + userID
+	fakeAPI.subResources[grantsPath] = []interface{}{
+		newFakeAPIGrant(userID, managedRole, homeDiv),
+		newFakeAPIGrant(userID, otherRole, homeDiv),
+	}
+
+	apply := func(state *terraform.InstanceState, divisionIDs ...string) *terraform.InstanceState {
+		divs := make([]interface{}, len(divisionIDs))
+		for i, div := range divisionIDs {
+			divs[i] = div
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"user_id":      userID,
+			"role_id":      managedRole,
+			"division_ids": divs,
+		})
+		diff, err := roleResource.Diff(ctx, state, config, meta)
+		if err != nil {
+			t.Fatalf("Failed to plan user role: %v", err)
+		}
+		newState, diagErr := roleResource.Apply(ctx, state, diff, meta)
+		if diagErr.HasError() {
+			t.Fatalf("Failed to apply user role: %v", diagErr)
+		}
+		return newState
+	}
+
+	granted := func(roleID string, divisionID string) bool {
+		grants, _ := fakeAPI.subResources[grantsPath].([]interface{})
+		return findFakeAPIGrant(grants, userID, roleID, divisionID) >= 0
+	}
+
+	// Only the configured division is read, although the role is also granted in the home division
+	state := apply(nil, div1)
+	if state.ID != createSubjectRoleID(userID, managedRole) {
+		t.Errorf("Unexpected user role ID %s", state.ID)
+	}
+	if state.Attributes["division_ids.#"] != "1" || !granted(managedRole, div1) {
+		t.Errorf("Expected role to be granted in division %s, got %v", div1, state.Attributes)
+	}
+
+	// Changing divisions only removes divisions managed by the resource
+	state = apply(state, div2)
+	if granted(managedRole, div1) || !granted(managedRole, div2) {
+		t.Errorf("Expected role to be moved from division %s to %s", div1, div2)
+	}
+	if !granted(managedRole, homeDiv) || !granted(otherRole, homeDiv) {
+		t.Error("Expected grants not managed by the user role to be unchanged")
+	}
+
+	// No changes are planned for grants made outside of the resource
+	diff, _ := roleResource.Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"user_id":      userID,
+		"role_id":      managedRole,
+		"division_ids": []interface{}{div2},
+	}), meta)
+	if diff != nil && !diff.Empty() {
+		t.Errorf("Expected no changes, got %v", diff.Attributes)
+	}
+
+	// Imported roles include all divisions the role is granted in
+	imported := roleResource.Data(&terraform.InstanceState{ID: state.ID})
+	if diagErr := readUserRole(ctx, imported, meta); diagErr.HasError() {
+		t.Fatalf("Failed to import user role: %v", diagErr)
+	}
+	importedDivs := *setToStringList(imported.Get("division_ids").(*schema.Set))
+	if imported.Get("user_id") != userID || len(importedDivs) != 2 || len(sliceDifference(importedDivs, []string{homeDiv, div2})) != 0 {
+		t.Errorf("Expected imported divisions %s and %s, got %v", homeDiv, div2, importedDivs)
+	}
+
+	// Destroy only removes the managed grants
+	if diagErr := deleteUserRole(ctx, roleResource.Data(state), meta); diagErr.HasError() {
+		t.Fatalf("Failed to delete user role: %v", diagErr)
+	}
+	if granted(managedRole, div2) || !granted(managedRole, homeDiv) || !granted(otherRole, homeDiv) {
+		t.Errorf("Expected only the managed grant to be removed, got %v", fakeAPI.subResources[grantsPath])
+	}
+
+	// The resource is removed from state when its grants are removed outside of Terraform
+	removed := roleResource.Data(state)
+	if diagErr := readUserRole(ctx, removed, meta); diagErr.HasError() {
+		t.Fatalf("Failed to read user role: %v", diagErr)
+	}
+	if removed.Id() != "" {
+		t.Errorf("Expected user role to be removed from state, got ID %s", removed.Id())
+	}
+}
+
+// Verifies a role is granted directly to a user or group in any division
+func testVerifySubjectRoleGranted(subjectResourceName string, roleResourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		subjectResource, ok := state.RootModule().Resources[subjectResourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find %s in state", subjectResourceName)
+		}
+		roleResource, ok := state.RootModule().Resources[roleResourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find role %s in state", roleResourceName)
+		}
+
+		authAPI := platformclientv2.NewAuthorizationApi()
+		divisionIDs, diagErr := getSubjectRoleDivisions(subjectResource.Primary.ID, roleResource.Primary.ID, authAPI)
+		if diagErr != nil {
+			return fmt.Errorf("%v", diagErr)
+		}
+		if len(divisionIDs) == 0 {
+			return fmt.Errorf("Role %s is not granted to %s", roleResource.Primary.ID, subjectResource.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func generateUserRole(resourceID string, userID string, roleID string, divisionIds ...string) string {
+	var divAttr string
+	if len(divisionIds) > 0 {
+		divAttr = "division_ids = [" + strings.Join(divisionIds, ",") + "]"
+	}
+	return fmt.Sprintf(`resource "genesyscloud_user_role" "%s" {
+		user_id = %s
+		role_id = %s
+		%s
+	}
+	`, resourceID, userID, roleID, divAttr)
+}
@@ -0,0 +1,189 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+// Station associations are applied asynchronously and new WebRTC stations may not exist immediately after their phone is created
+const userStationTimeout = 2 * time.Minute
+
+func resourceUserStation() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud User Station maintains the default and associated stations of a user.`,
+
+		CreateContext: createWithPooledClient(createUserStation),
+		ReadContext:   readWithPooledClient(readUserStation),
+		UpdateContext: updateWithPooledClient(updateUserStation),
+		DeleteContext: deleteWithPooledClient(deleteUserStation),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "User ID that will be managed by this resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"default_station_id": {
+				Description: "Station ID the user is associated with when they log in. If not set, the user will have no default station.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"associated_station_id": {
+				Description: "Station ID the user is currently associated with. The associated station changes when the user logs in to a different phone, so it is only managed when set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"effective_station_id": {
+				Description: "Station ID the user will use for calls. This is the associated station, or the default station if the user is not associated with a station.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func createUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	d.SetId(userID)
+	return updateUserStation(ctx, d, meta)
+}
+
+func readUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usersAPI := meta.(*providerMeta).usersProxy()
+
+	log.Printf("Reading stations for user %s", d.Id())
+
+	return withRetriesForRead(ctx, 30*time.Second, d, func() *resource.RetryError {
+		stations, resp, getErr := usersAPI.GetUserStation(d.Id())
+		if getErr != nil {
+			if isStatus404(resp) {
+				return resource.RetryableError(fmt.Errorf("Failed to read stations for user %s: %s", d.Id(), getErr))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to read stations for user %s: %s", d.Id(), getErr))
+		}
+
+		d.Set("user_id", d.Id())
+		d.Set("default_station_id", getUserStationID(stations.DefaultStation))
+		if _, managed := d.GetOk("associated_station_id"); managed {
+			d.Set("associated_station_id", getUserStationID(stations.AssociatedStation))
+		}
+		d.Set("effective_station_id", getUserStationID(stations.EffectiveStation))
+
+		log.Printf("Read stations for user %s", d.Id())
+		return nil
+	})
+}
+
+func updateUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usersAPI := meta.(*providerMeta).usersProxy()
+
+	defaultStationID := d.Get("default_station_id").(string)
+	associatedStationID := d.Get("associated_station_id").(string)
+
+	log.Printf("Updating stations for user %s", d.Id())
+
+	// The default station is always managed, so it is also cleared on create if not set
+	if d.IsNewResource() || d.HasChange("default_station_id") {
+		diagErr := setUserStation(ctx, d.Id(), "default", defaultStationID,
+			usersAPI.PutUserStationDefaultstationStationId, usersAPI.DeleteUserStationDefaultstation)
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	if d.HasChange("associated_station_id") {
+		diagErr := setUserStation(ctx, d.Id(), "associated", associatedStationID,
+			usersAPI.PutUserStationAssociatedstationStationId, usersAPI.DeleteUserStationAssociatedstation)
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	// Wait for the new stations to be returned by the API so the read does not report the previous association
+	diagErr := withRetries(ctx, userStationTimeout, func() *resource.RetryError {
+		stations, _, getErr := usersAPI.GetUserStation(d.Id())
+		if getErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("Failed to read stations for user %s: %s", d.Id(), getErr))
+		}
+		if getUserStationID(stations.DefaultStation) != defaultStationID {
+			return resource.RetryableError(fmt.Errorf("Default station for user %s has not been updated to %s", d.Id(), defaultStationID))
+		}
+		if d.HasChange("associated_station_id") && getUserStationID(stations.AssociatedStation) != associatedStationID {
+			return resource.RetryableError(fmt.Errorf("Associated station for user %s has not been updated to %s", d.Id(), associatedStationID))
+		}
+		return nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated stations for user %s", d.Id())
+	return readUserStation(ctx, d, meta)
+}
+
+func deleteUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usersAPI := meta.(*providerMeta).usersProxy()
+
+	// Does not delete the user or stations. The user is removed from any stations managed by this resource.
+	log.Printf("Removing stations for user %s", d.Id())
+	resp, err := usersAPI.DeleteUserStationDefaultstation(d.Id())
+	if err != nil && !isStatus404(resp) {
+		return diag.Errorf("Failed to remove default station for user %s: %s", d.Id(), err)
+	}
+	if _, managed := d.GetOk("associated_station_id"); managed {
+		resp, err := usersAPI.DeleteUserStationAssociatedstation(d.Id())
+		if err != nil && !isStatus404(resp) {
+			return diag.Errorf("Failed to remove associated station for user %s: %s", d.Id(), err)
+		}
+	}
+	log.Printf("Removed stations for user %s", d.Id())
+	return nil
+}
+
+func setUserStation(
+	ctx context.Context,
+	userID string,
+	stationType string,
+	stationID string,
+	putStation func(userID string, stationID string) (*platformclientv2.APIResponse, error),
+	deleteStation func(userID string) (*platformclientv2.APIResponse, error)) diag.Diagnostics {
+	if stationID == "" {
+		log.Printf("Removing %s station for user %s", stationType, userID)
+		resp, err := deleteStation(userID)
+		if err != nil && !isStatus404(resp) {
+			return diag.Errorf("Failed to remove %s station for user %s: %s", stationType, userID, err)
+		}
+		return nil
+	}
+
+	log.Printf("Setting %s station for user %s to %s", stationType, userID, stationID)
+	return withRetries(ctx, userStationTimeout, func() *resource.RetryError {
+		resp, err := putStation(userID, stationID)
+		if err != nil {
+			if isStatus404(resp) {
+				// Stations for new WebRTC phones are created asynchronously
+				return resource.RetryableError(fmt.Errorf("Failed to set %s station for user %s to %s: %s", stationType, userID, stationID, err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Failed to set %s station for user %s to %s: %s", stationType, userID, stationID, err))
+		}
+		return nil
+	})
+}
+
+func getUserStationID(station *platformclientv2.Userstation) string {
+	if station == nil || station.Id == nil {
+		return ""
+	}
+	return *station.Id
+}
@@ -0,0 +1,247 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+func TestAccResourceUserStation(t *testing.T) {
+	var (
+		userStationResource = "test-user-station"
+		userResource1       = "test-user"
+		email1              = "terraform-" + uuid.NewString() + "@example.com"
+		userName1           = "Station Terraform"
+
+		phoneRes              = "test-phone"
+		phoneName             = "test-phone-" + uuid.NewString()
+		phoneBaseSettingsRes  = "test-phone-base-settings"
+		phoneBaseSettingsName = "test-phone-base-settings-" + uuid.NewString()
+		stationDataRes        = "test-station"
+	)
+
+	testAccPreCheck(t)
+	err := authorizeSdk()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	siteId, err := getDefaultSiteId()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := generateBasicUserResource(
+		userResource1,
+		email1,
+		userName1,
+	) + generatePhoneBaseSettingsResourceWithCustomAttrs(
+		phoneBaseSettingsRes,
+		phoneBaseSettingsName,
+		"phoneBaseSettings description",
+		"inin_webrtc_softphone.json",
+	) + generatePhoneResourceWithCustomAttrs(&phoneConfig{
+		phoneRes,
+		phoneName,
+		"active",
+		siteId,
+		"genesyscloud_telephony_providers_edges_phonebasesettings." + phoneBaseSettingsRes + ".id",
+		"genesyscloud_telephony_providers_edges_phonebasesettings." + phoneBaseSettingsRes + ".line_base_settings_id",
+		nil, // no line addresses
+		"genesyscloud_user." + userResource1 + ".id",
+		"", // no depends on
+	}) + generateStationDataSource(
+		stationDataRes,
+		"genesyscloud_telephony_providers_edges_phone."+phoneRes+".name",
+		"genesyscloud_telephony_providers_edges_phone."+phoneRes,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Set the user's default station
+				Config: config + generateUserStation(
+					userStationResource,
+					userResource1,
+					"data.genesyscloud_station."+stationDataRes+".id",
+					nullValue, // Associated station not managed
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_user_station."+userStationResource, "default_station_id", "data.genesyscloud_station."+stationDataRes, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_user_station."+userStationResource, "associated_station_id", ""),
+				),
+			},
+			{
+				// Associate the user with the station
+				Config: config + generateUserStation(
+					userStationResource,
+					userResource1,
+					"data.genesyscloud_station."+stationDataRes+".id",
+					"data.genesyscloud_station."+stationDataRes+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_user_station."+userStationResource, "default_station_id", "data.genesyscloud_station."+stationDataRes, "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_user_station."+userStationResource, "associated_station_id", "data.genesyscloud_station."+stationDataRes, "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_user_station."+userStationResource, "effective_station_id", "data.genesyscloud_station."+stationDataRes, "id"),
+				),
+			},
+			{
+				// Remove the default station
+				Config: config + generateUserStation(
+					userStationResource,
+					userResource1,
+					nullValue,
+					"data.genesyscloud_station."+stationDataRes+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user_station."+userStationResource, "default_station_id", ""),
+					resource.TestCheckResourceAttrPair("genesyscloud_user_station."+userStationResource, "associated_station_id", "data.genesyscloud_station."+stationDataRes, "id"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_user_station." + userStationResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The associated station is only read when it is managed
+				ImportStateVerifyIgnore: []string{"associated_station_id"},
+			},
+		},
+		CheckDestroy: testVerifyWebRtcPhoneDestroyed,
+	})
+}
+
+func TestUnitUserStation(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	ctx := context.Background()
+	stationResource := resourceUserStation()
+
+	user := fakeAPI.create("/api/v2/users", map[string]interface{}{"name": "User", "email": "user@example.com"})
+	userID := user["id"].(string)
+	station1 := fakeAPI.create("/api/v2/stations", map[string]interface{}{"name": "Station 1"})["id"].(string)
+	station2 := fakeAPI.create("/api/v2/stations", map[string]interface{}{"name": "Station 2"})["id"].(string)
+
+	apply := func(state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceState {
+		diff, err := stationResource.Diff(ctx, state, terraform.NewResourceConfigRaw(config), meta)
+		if err != nil {
+			t.Fatalf("Failed to plan user station: %v", err)
+		}
+		newState, diagErr := stationResource.Apply(ctx, state, diff, meta)
+		if diagErr.HasError() {
+			t.Fatalf("Failed to apply user station: %v", diagErr)
+		}
+		return newState
+	}
+
+	// Set the default station
+	state := apply(nil, map[string]interface{}{
+		"user_id":            userID,
+		"default_station_id": station1,
+	})
+	if state.Attributes["default_station_id"] != station1 || state.Attributes["effective_station_id"] != station1 {
+		t.Errorf("Expected default station %s, got %v", station1, state.Attributes)
+	}
+
+	// Associations are not returned until the API has applied them
+	state = apply(state, map[string]interface{}{
+		"user_id":               userID,
+		"default_station_id":    station1,
+		"associated_station_id": station2,
+	})
+	if state.Attributes["associated_station_id"] != station2 || state.Attributes["effective_station_id"] != station2 {
+		t.Errorf("Expected associated station %s, got %v", station2, state.Attributes)
+	}
+
+	// No changes are planned once the association has been applied
+	diff, _ := stationResource.Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"user_id":               userID,
+		"default_station_id":    station1,
+		"associated_station_id": station2,
+	}), meta)
+	if diff != nil && !diff.Empty() {
+		t.Errorf("Expected no changes, got %v", diff.Attributes)
+	}
+
+	// Removing the default station clears it
+	state = apply(state, map[string]interface{}{
+		"user_id":               userID,
+		"associated_station_id": station2,
+	})
+	if state.Attributes["default_station_id"] != "" || state.Attributes["associated_station_id"] != station2 {
+		t.Errorf("Expected only the associated station, got %v", state.Attributes)
+	}
+
+	// Destroy removes the user from any managed stations
+	if diagErr := deleteUserStation(ctx, stationResource.Data(state), meta); diagErr.HasError() {
+		t.Fatalf("Failed to delete user station: %v", diagErr)
+	}
+	stations := fakeAPI.subResources["/api/v2/users/"+userID+"/station"].(map[string]interface{})
+	if len(stations) != 0 {
+		t.Errorf("Expected no stations for the user, got %v", stations)
+	}
+}
+
+// fakeUserStationProxy is an in-memory users proxy where stations are not found until they have been requested twice,
+// like the stations of new WebRTC phones
+type fakeUserStationProxy struct {
+	usersProxy
+
+	stationRequests map[string]int
+	defaultStation  string
+}
+
+func (p *fakeUserStationProxy) GetUserStation(userID string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error) {
+	stations := &platformclientv2.Userstations{}
+	if p.defaultStation != "" {
+		stations.DefaultStation = &platformclientv2.Userstation{Id: &p.defaultStation}
+		stations.EffectiveStation = stations.DefaultStation
+	}
+	return stations, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+}
+
+func (p *fakeUserStationProxy) PutUserStationDefaultstationStationId(userID string, stationID string) (*platformclientv2.APIResponse, error) {
+	p.stationRequests[stationID]++
+	if p.stationRequests[stationID] < 2 {
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("station %s not found", stationID)
+	}
+	p.defaultStation = stationID
+	return &platformclientv2.APIResponse{StatusCode: http.StatusAccepted}, nil
+}
+
+func TestUnitUserStationNewStationRetry(t *testing.T) {
+	proxy := &fakeUserStationProxy{stationRequests: make(map[string]int)}
+	meta := &providerMeta{proxies: &apiProxies{users: proxy}}
+	stationResource := resourceUserStation()
+
+	d := schema.TestResourceDataRaw(t, stationResource.Schema, map[string]interface{}{
+		"user_id":            "user-id",
+		"default_station_id": "new-station",
+	})
+	if diagErr := createUserStation(context.Background(), d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to create user station: %v", diagErr)
+	}
+	if proxy.stationRequests["new-station"] != 2 {
+		t.Errorf("Expected the default station to be retried once, got %d requests", proxy.stationRequests["new-station"])
+	}
+	if d.Get("effective_station_id") != "new-station" {
+		t.Errorf("Expected effective station new-station, got %v", d.Get("effective_station_id"))
+	}
+}
+
+func generateUserStation(resourceID string, userResource string, defaultStationID string, associatedStationID string) string {
+	return fmt.Sprintf(`resource "genesyscloud_user_station" "%s" {
+		user_id = genesyscloud_user.%s.id
+		default_station_id = %s
+		associated_station_id = %s
+	}
+	`, resourceID, userResource, defaultStationID, associatedStationID)
+}
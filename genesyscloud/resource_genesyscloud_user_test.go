@@ -1,6 +1,7 @@
 package genesyscloud
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
 )
@@ -201,7 +203,7 @@ func TestAccResourceUserAddresses(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("genesyscloud_user."+addrUserResource1, "email", addrEmail1),
 					resource.TestCheckResourceAttr("genesyscloud_user."+addrUserResource1, "name", addrUserName),
-					resource.TestCheckResourceAttr("genesyscloud_user."+addrUserResource1, "addresses.0.phone_numbers.0.number", addrPhone1),
+					resource.TestCheckResourceAttr("genesyscloud_user."+addrUserResource1, "addresses.0.phone_numbers.0.number", "+1"+addrPhone1), // Stored in E.164 format
 					resource.TestCheckResourceAttr("genesyscloud_user."+addrUserResource1, "addresses.0.phone_numbers.0.media_type", phoneMediaType),
 					resource.TestCheckResourceAttr("genesyscloud_user."+addrUserResource1, "addresses.0.phone_numbers.0.type", addrTypeWork),
 					resource.TestCheckResourceAttr("genesyscloud_user."+addrUserResource1, "addresses.0.other_emails.0.address", addrEmail2),
@@ -249,6 +251,125 @@ func TestAccResourceUserAddresses(t *testing.T) {
 	})
 }
 
+func TestUnitUserStateUpgradeV1(t *testing.T) {
+	upgraders := resourceUser().StateUpgraders
+	if len(upgraders) != 1 || upgraders[0].Version != 1 {
+		t.Fatalf("Expected a single state upgrader from version 1, got %v", upgraders)
+	}
+
+	rawState := map[string]interface{}{
+		"email": "user@example.com",
+		"addresses": []interface{}{
+			map[string]interface{}{
+				"phone_numbers": []interface{}{
+					map[string]interface{}{"number": "(317) 426-9078", "type": "WORK"},
+					map[string]interface{}{"number": "+441434634996", "type": "HOME"},
+					map[string]interface{}{"number": "not a number", "type": "MOBILE"},
+				},
+			},
+		},
+	}
+
+	upgraded, err := upgraders[0].Upgrade(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("Failed to upgrade state: %v", err)
+	}
+
+	phoneNumbers := upgraded["addresses"].([]interface{})[0].(map[string]interface{})["phone_numbers"].([]interface{})
+	for i, expected := range []string{"+13174269078", "+441434634996", "not a number"} {
+		if number := phoneNumbers[i].(map[string]interface{})["number"]; number != expected {
+			t.Errorf("Expected phone number %d to be %s, got %v", i, expected, number)
+		}
+	}
+
+	// State without addresses is unchanged
+	noAddresses := map[string]interface{}{"email": "user@example.com"}
+	if _, err := upgraders[0].Upgrade(context.Background(), noAddresses, nil); err != nil {
+		t.Fatalf("Failed to upgrade state without addresses: %v", err)
+	}
+}
+func TestAccResourceUserManagerEmail(t *testing.T) {
+	var (
+		managerResource = "test-manager"
+		userResource    = "test-managed-user"
+		managerEmail    = "terraform-manager-" + uuid.NewString() + "@example.com"
+		userEmail       = "terraform-" + uuid.NewString() + "@example.com"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// The manager is created in the same apply without a dependency
+				Config: generateBasicUserResource(managerResource, managerEmail, "Manager Terraform") + generateUserWithCustomAttrs(
+					userResource,
+					userEmail,
+					"Managed Terraform",
+					fmt.Sprintf("manager_email = %s", strconv.Quote(strings.ToUpper(managerEmail))),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource, "manager_email", managerEmail),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource, "manager", ""),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_user." + userResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manager", "manager_email"},
+			},
+		},
+		CheckDestroy: testVerifyUsersDestroyed,
+	})
+}
+
+func TestUnitUserManagerEmail(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	ctx := context.Background()
+	manager := fakeAPI.create("/api/v2/users", map[string]interface{}{"name": "Manager", "email": "manager@example.com"})
+	managerID := manager["id"].(string)
+
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"email":         "user@example.com",
+		"name":          "User",
+		"manager_email": "Manager@Example.com",
+	})
+	id, diagErr := getUserManagerID(ctx, d, meta)
+	if diagErr.HasError() {
+		t.Fatalf("Failed to find manager: %v", diagErr)
+	}
+	if id != managerID {
+		t.Errorf("Expected manager ID %s, got %s", managerID, id)
+	}
+
+	// Managers configured by email are read by email
+	sdkManager := &platformclientv2.User{Id: &managerID}
+	if diagErr := readUserManager(ctx, d, meta, &sdkManager); diagErr.HasError() {
+		t.Fatalf("Failed to read manager: %v", diagErr)
+	}
+	if d.Get("manager_email") != "manager@example.com" || d.Get("manager") != "" {
+		t.Errorf("Expected manager read by email, got manager %v and manager_email %v", d.Get("manager"), d.Get("manager_email"))
+	}
+
+	// Managers configured by ID are read by ID
+	d = schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"email":   "user@example.com",
+		"name":    "User",
+		"manager": managerID,
+	})
+	if id, _ := getUserManagerID(ctx, d, meta); id != managerID {
+		t.Errorf("Expected manager ID %s, got %s", managerID, id)
+	}
+	if diagErr := readUserManager(ctx, d, meta, &sdkManager); diagErr.HasError() {
+		t.Fatalf("Failed to read manager: %v", diagErr)
+	}
+	if d.Get("manager") != managerID || d.Get("manager_email") != "" {
+		t.Errorf("Expected manager read by ID, got manager %v and manager_email %v", d.Get("manager"), d.Get("manager_email"))
+	}
+}
+
 func TestAccResourceUserSkills(t *testing.T) {
 	var (
 		userResource1  = "test-user"
@@ -724,6 +845,189 @@ func TestAccResourceUserRestore(t *testing.T) {
 	})
 }
 
+func TestAccResourceUserVoicemailAndCallForwarding(t *testing.T) {
+	var (
+		userResource1 = "test-user-forwarding"
+		userName      = "Terraform Forwarding"
+		email1        = "terraform-" + uuid.NewString() + "@example.com"
+		alertTimeout1 = "20"
+		alertTimeout2 = "35"
+		phoneNumber1  = "+13175550100"
+		phoneNumber2  = "+13175550101"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create with voicemail policy and call forwarding to one number
+				Config: generateUserWithCustomAttrs(
+					userResource1,
+					email1,
+					userName,
+					generateUserVoicemailPolicies(alertTimeout1, trueValue),
+					generateUserCallForwarding(
+						trueValue,
+						strconv.Quote("PURECLOUD"),
+						generateUserCallRoute(generateUserCallTarget("PHONE", phoneNumber1)),
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.alert_timeout_seconds", alertTimeout1),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.send_email_notifications", trueValue),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "call_forwarding.0.enabled", trueValue),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "call_forwarding.0.voicemail", "PURECLOUD"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "call_forwarding.0.calls.0.targets.0.type", "PHONE"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "call_forwarding.0.calls.0.targets.0.value", phoneNumber1),
+				),
+			},
+			{
+				// Update voicemail policy and forward to two numbers in order
+				Config: generateUserWithCustomAttrs(
+					userResource1,
+					email1,
+					userName,
+					generateUserVoicemailPolicies(alertTimeout2, falseValue),
+					generateUserCallForwarding(
+						falseValue,
+						strconv.Quote("LASTCALL"),
+						generateUserCallRoute(generateUserCallTarget("PHONE", phoneNumber1)),
+						generateUserCallRoute(generateUserCallTarget("PHONE", phoneNumber2)),
+					),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.alert_timeout_seconds", alertTimeout2),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "voicemail_userpolicies.0.send_email_notifications", falseValue),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "call_forwarding.0.enabled", falseValue),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "call_forwarding.0.voicemail", "LASTCALL"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "call_forwarding.0.calls.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "call_forwarding.0.calls.1.targets.0.value", phoneNumber2),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_user." + userResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"voicemail_userpolicies.0.pin"},
+			},
+		},
+		CheckDestroy: testVerifyUsersDestroyed,
+	})
+}
+
+func TestUnitUserVoicemailAndCallForwarding(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	user := fakeAPI.create("/api/v2/users", map[string]interface{}{"name": "User", "email": "user@example.com"})
+	userID := user["id"].(string)
+	usersAPI := platformclientv2.NewUsersApiWithConfig(meta.ClientConfig)
+
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"email": "user@example.com",
+		"name":  "User",
+		"voicemail_userpolicies": []interface{}{map[string]interface{}{
+			"alert_timeout_seconds":    20,
+			"send_email_notifications": false,
+			"pin":                      "1234",
+		}},
+		"call_forwarding": []interface{}{map[string]interface{}{
+			"enabled": true,
+			"calls": []interface{}{
+				map[string]interface{}{"targets": []interface{}{
+					map[string]interface{}{"type": "PHONE", "value": "(317) 555-0100"},
+				}},
+				map[string]interface{}{"targets": []interface{}{
+					map[string]interface{}{"type": "STATION", "value": "station-id"},
+				}},
+			},
+		}},
+	})
+	d.SetId(userID)
+
+	if diagErr := updateUserVoicemailPolicies(d, meta.ClientConfig); diagErr.HasError() {
+		t.Fatalf("Failed to update voicemail policy: %v", diagErr)
+	}
+	if diagErr := updateUserCallForwarding(d, usersAPI); diagErr.HasError() {
+		t.Fatalf("Failed to update call forwarding: %v", diagErr)
+	}
+	policy := fakeAPI.subResources["/api/v2/voicemail/userpolicies/"+userID].(map[string]interface{})
+	if policy["pin"] != "1234" || policy["sendEmailNotifications"] != false {
+		t.Errorf("Expected PIN and email notifications to be updated, got %v", policy)
+	}
+
+	// Phone numbers are sent in E.164 format and routes keep their order
+	forwarding := fakeAPI.subResources["/api/v2/users/"+userID+"/callforwarding"].(map[string]interface{})
+	calls := forwarding["calls"].([]interface{})
+	if len(calls) != 2 {
+		t.Fatalf("Expected 2 call routes, got %v", calls)
+	}
+	firstTarget := calls[0].(map[string]interface{})["targets"].([]interface{})[0].(map[string]interface{})
+	if firstTarget["value"] != "+13175550100" {
+		t.Errorf("Expected phone number in E.164 format, got %v", firstTarget["value"])
+	}
+
+	if diagErr := readUserVoicemailPolicies(d, meta.ClientConfig); diagErr.HasError() {
+		t.Fatalf("Failed to read voicemail policy: %v", diagErr)
+	}
+	if diagErr := readUserCallForwarding(d, usersAPI); diagErr.HasError() {
+		t.Fatalf("Failed to read call forwarding: %v", diagErr)
+	}
+	if d.Get("voicemail_userpolicies.0.alert_timeout_seconds") != 20 || d.Get("voicemail_userpolicies.0.pin") != "1234" {
+		t.Errorf("Expected voicemail policy to be read with the configured PIN, got %v", d.Get("voicemail_userpolicies"))
+	}
+	if d.Get("call_forwarding.0.calls.1.targets.0.value") != "station-id" || d.Get("call_forwarding.0.enabled") != true {
+		t.Errorf("Expected call forwarding to be read, got %v", d.Get("call_forwarding"))
+	}
+	if !compareCallTargets("call_forwarding.0.calls.0.targets.0.value", "+13175550100", "3175550100", d) {
+		t.Error("Expected phone number targets to be compared in E.164 format")
+	}
+}
+
+// forbiddenCallForwardingProxy denies reading call forwarding and counts the attempts
+type forbiddenCallForwardingProxy struct {
+	usersProxy
+	reads int
+}
+
+func (p *forbiddenCallForwardingProxy) GetUserCallforwarding(userId string) (*platformclientv2.Callforwarding, *platformclientv2.APIResponse, error) {
+	p.reads++
+	return nil, &platformclientv2.APIResponse{StatusCode: 403}, fmt.Errorf("missing permission to read call forwarding")
+}
+
+func TestUnitUserCallForwardingReadWhenManaged(t *testing.T) {
+	fakeAPI, sharedMeta := setupFakeAPI(t)
+	ctx := context.Background()
+	user := fakeAPI.create("/api/v2/users", map[string]interface{}{"name": "User", "email": "user@example.com"})
+
+	usersAPI := &forbiddenCallForwardingProxy{usersProxy: sharedMeta.usersProxy()}
+	meta := *sharedMeta
+	meta.proxies = &apiProxies{users: usersAPI}
+
+	// Call forwarding is not read for users that don't manage it
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"email": "user@example.com",
+		"name":  "User",
+	})
+	d.SetId(user["id"].(string))
+	if diagErr := readUser(ctx, d, &meta); diagErr.HasError() {
+		t.Fatalf("Failed to read user: %v", diagErr)
+	}
+	if usersAPI.reads != 0 {
+		t.Errorf("Expected call forwarding not to be read, got %d reads", usersAPI.reads)
+	}
+
+	// Imported users read call forwarding, and missing permissions are not an error
+	d = schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{})
+	d.SetId(user["id"].(string))
+	if diagErr := readUser(ctx, d, &meta); diagErr.HasError() {
+		t.Fatalf("Failed to read user: %v", diagErr)
+	}
+	if usersAPI.reads != 1 {
+		t.Errorf("Expected call forwarding to be read once, got %d reads", usersAPI.reads)
+	}
+}
+
 func testVerifyUsersDestroyed(state *terraform.State) error {
 	usersAPI := platformclientv2.NewUsersApi()
 	for _, rs := range state.RootModule().Resources {
@@ -941,3 +1245,35 @@ func generateUserLocation(locResource string, notes string) string {
 			}
 			`, locResource, notes)
 }
+
+func generateUserVoicemailPolicies(alertTimeoutSeconds string, sendEmailNotifications string) string {
+	return fmt.Sprintf(`voicemail_userpolicies {
+		alert_timeout_seconds = %s
+		send_email_notifications = %s
+	}
+	`, alertTimeoutSeconds, sendEmailNotifications)
+}
+
+func generateUserCallForwarding(enabled string, voicemail string, calls ...string) string {
+	return fmt.Sprintf(`call_forwarding {
+		enabled = %s
+		voicemail = %s
+		%s
+	}
+	`, enabled, voicemail, strings.Join(calls, "\n"))
+}
+
+func generateUserCallRoute(targets ...string) string {
+	return fmt.Sprintf(`calls {
+			%s
+		}
+		`, strings.Join(targets, "\n"))
+}
+
+func generateUserCallTarget(targetType string, value string) string {
+	return fmt.Sprintf(`targets {
+				type = "%s"
+				value = "%s"
+			}
+			`, targetType, value)
+}
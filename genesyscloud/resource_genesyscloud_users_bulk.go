@@ -0,0 +1,1068 @@
+package genesyscloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+const (
+	bulkUsersFormatCSV  = "csv"
+	bulkUsersFormatJSON = "json"
+
+	bulkUserColumnEmail        = "email"
+	bulkUserColumnName         = "name"
+	bulkUserColumnDivision     = "division"
+	bulkUserColumnManagerEmail = "manager_email"
+	bulkUserColumnSkills       = "skills"
+	bulkUserColumnLanguages    = "languages"
+
+	// Maximum number of users requested by ID or email in a single API call
+	bulkUsersBatchSize = 100
+)
+
+// Columns in the order they are stored in managed_columns. Email and name are required.
+var bulkUserColumns = []string{
+	bulkUserColumnEmail,
+	bulkUserColumnName,
+	bulkUserColumnDivision,
+	bulkUserColumnManagerEmail,
+	bulkUserColumnSkills,
+	bulkUserColumnLanguages,
+}
+
+// bulkUser is a user row from the users file or the API. Emails and the names of divisions, skills, and languages
+// are lowercase so rows from the file can be compared to users read from the API.
+// An empty division is the home division.
+type bulkUser struct {
+	Email        string             `json:"email"`
+	Name         string             `json:"name"`
+	Division     string             `json:"division,omitempty"`
+	ManagerEmail string             `json:"manager_email,omitempty"`
+	Skills       map[string]float64 `json:"skills,omitempty"`
+	Languages    map[string]int     `json:"languages,omitempty"`
+}
+
+// bulkUserJSON is a user in a JSON users file. Optional attributes are pointers so unset attributes can be detected.
+type bulkUserJSON struct {
+	Email        string              `json:"email"`
+	Name         string              `json:"name"`
+	Division     *string             `json:"division"`
+	ManagerEmail *string             `json:"manager_email"`
+	Skills       *map[string]float64 `json:"skills"`
+	Languages    *map[string]int     `json:"languages"`
+}
+
+// bulkUsersSource is implemented by schema.ResourceData and schema.ResourceDiff
+type bulkUsersSource interface {
+	Get(key string) interface{}
+}
+
+func resourceUsersBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Users Bulk. Manages a large set of users described in a CSV or JSON file. Only a hash of each user is stored in state, and only users that differ from the file are updated. Users removed from the file are deleted unless deletion protection is enabled. Users are saved to state as they are changed, so an apply that fails part way only changes the remaining users when it is retried.",
+
+		CreateContext: createWithPooledClient(createUsersBulk),
+		ReadContext:   readWithPooledClient(readUsersBulk),
+		UpdateContext: updateWithPooledClient(updateUsersBulk),
+		DeleteContext: deleteWithPooledClient(protectFromDeletion("Bulk users", deleteUsersBulk)),
+		CustomizeDiff: customizeUsersBulkDiff,
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"source_file": {
+				Description:  "Path to a CSV or JSON file describing the users. A CSV file has a header row with the columns email, name, division, manager_email, skills, and languages. Email and name are required, and other columns are only managed if they are included. Skills and languages are lists of name:proficiency separated by semicolons, e.g. `Sales:3;Support:4.5`. An empty division is the home division, and an empty manager_email removes the user's manager.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_file", "content"},
+			},
+			"content": {
+				Description:  "CSV or JSON content describing the users. JSON is an array of objects with the same attributes as the CSV columns. Skills and languages are objects with proficiencies keyed by name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_file", "content"},
+			},
+			"format": {
+				Description:  "Format of the users (csv | json). Defaults to json for `.json` files and content starting with `[`, otherwise csv.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{bulkUsersFormatCSV, bulkUsersFormatJSON}, false),
+			},
+			"allow_empty": {
+				Description: "Allow a users file with no users. All managed users are deleted when the file is empty, so this must be set to apply an empty file.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"deletion_protection": deletionProtectionSchema(),
+			"users": {
+				Description: "IDs of the managed users keyed by lowercase email.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"row_hashes": {
+				Description: "Hash of each user's managed attributes keyed by lowercase email. Plans show a change to this map for each user that will be added, updated, or removed.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"managed_columns": {
+				Description: "Columns managed by the users file.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"content_hash": {
+				Description: "Hash of all managed users. Changes when a user in the file or in Genesys Cloud changes.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func customizeUsersBulkDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("source_file") || !diff.NewValueKnown("content") || !diff.NewValueKnown("format") {
+		// Users can't be compared until the file is known
+		for _, attr := range []string{"users", "row_hashes", "managed_columns", "content_hash"} {
+			if err := diff.SetNewComputed(attr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	users, columns, err := loadBulkUsers(diff)
+	if err != nil {
+		return err
+	}
+
+	rowHashes := bulkUserRowHashes(users, columns)
+	oldHashes := diff.Get("row_hashes").(map[string]interface{})
+	oldColumns := interfaceListToStrings(diff.Get("managed_columns").([]interface{}))
+	if reflect.DeepEqual(rowHashes, oldHashes) && reflect.DeepEqual(columns, oldColumns) {
+		return nil
+	}
+
+	log.Printf("Users file has changes to %d users", countChangedBulkUsers(oldHashes, rowHashes))
+	if err := diff.SetNew("row_hashes", rowHashes); err != nil {
+		return err
+	}
+	if err := diff.SetNew("managed_columns", columns); err != nil {
+		return err
+	}
+	if err := diff.SetNew("content_hash", bulkUsersContentHash(rowHashes)); err != nil {
+		return err
+	}
+
+	// IDs are only known after new users are created or removed users are deleted
+	oldIDs := diff.Get("users").(map[string]interface{})
+	if len(oldIDs) != len(rowHashes) {
+		return diff.SetNewComputed("users")
+	}
+	for email := range rowHashes {
+		if _, found := oldIDs[email]; !found {
+			return diff.SetNewComputed("users")
+		}
+	}
+	return nil
+}
+
+func createUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+	return updateUsersBulk(ctx, d, meta)
+}
+
+func readUsersBulk(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*providerMeta).ClientConfig
+	usersAPI := meta.(*providerMeta).usersProxy()
+
+	columns := interfaceListToStrings(d.Get("managed_columns").([]interface{}))
+	userIDs := d.Get("users").(map[string]interface{})
+
+	log.Printf("Reading %d bulk users %s", len(userIDs), d.Id())
+	ids := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		ids = append(ids, id.(string))
+	}
+	apiUsers, diagErr := getBulkUsersByID(ids, []string{"skills", "languages"}, usersAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	// Managers are compared by email
+	managerEmails := make(map[string]string)
+	var missingManagerIDs []string
+	if stringInSlice(bulkUserColumnManagerEmail, columns) {
+		for _, user := range apiUsers {
+			if user.Manager == nil || (*user.Manager).Id == nil {
+				continue
+			}
+			managerID := *(*user.Manager).Id
+			if manager, found := apiUsers[managerID]; found && manager.Email != nil {
+				managerEmails[managerID] = strings.ToLower(*manager.Email)
+			} else {
+				missingManagerIDs = append(missingManagerIDs, managerID)
+			}
+		}
+		managers, diagErr := getBulkUsersByID(missingManagerIDs, nil, usersAPI)
+		if diagErr != nil {
+			return diagErr
+		}
+		for id, manager := range managers {
+			if manager.Email != nil {
+				managerEmails[id] = strings.ToLower(*manager.Email)
+			}
+		}
+	}
+
+	var divisions *bulkUserDivisions
+	if stringInSlice(bulkUserColumnDivision, columns) {
+		divisions, diagErr = getBulkUserDivisions(platformclientv2.NewAuthorizationApiWithConfig(sdkConfig))
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	currentIDs := make(map[string]interface{}, len(userIDs))
+	rowHashes := make(map[string]interface{}, len(userIDs))
+	for email, id := range userIDs {
+		apiUser, found := apiUsers[id.(string)]
+		if !found {
+			log.Printf("User %s no longer exists", email)
+			continue
+		}
+		currentIDs[email] = id
+		rowHashes[email] = bulkUserHash(flattenBulkUser(apiUser, managerEmails, divisions), columns)
+	}
+
+	d.Set("users", currentIDs)
+	d.Set("row_hashes", rowHashes)
+	d.Set("content_hash", bulkUsersContentHash(rowHashes))
+
+	log.Printf("Read %d bulk users %s", len(currentIDs), d.Id())
+	return nil
+}
+
+func updateUsersBulk(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	users, columns, err := loadBulkUsers(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdkConfig := meta.(*providerMeta).ClientConfig
+	usersAPI := meta.(*providerMeta).usersProxy()
+	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+
+	// Planned values of the computed attributes are from the file, so compare to the previous state
+	oldIDs, _ := d.GetChange("users")
+	oldHashes, _ := d.GetChange("row_hashes")
+	userIDs := make(map[string]string)
+	for email, id := range oldIDs.(map[string]interface{}) {
+		userIDs[email] = id.(string)
+	}
+	rowHashes := bulkUserRowHashes(users, columns)
+
+	// Users are saved to state as they are applied, so an apply that fails part way only changes the remaining users when it is retried
+	appliedUsers := make(map[string]bool)
+	saveProgress := func(diagErr diag.Diagnostics) diag.Diagnostics {
+		setBulkUsersProgress(d, userIDs, appliedUsers, rowHashes, oldHashes.(map[string]interface{}), columns)
+		return diagErr
+	}
+
+	lookups, diagErr := getBulkUserLookups(columns, sdkConfig)
+	if diagErr != nil {
+		return saveProgress(diagErr)
+	}
+	if diagErr := validateBulkUserLookups(users, lookups); diagErr != nil {
+		return saveProgress(diagErr)
+	}
+
+	var removedEmails []string
+	for email := range userIDs {
+		if _, found := rowHashes[email]; !found {
+			removedEmails = append(removedEmails, email)
+		}
+	}
+	if len(removedEmails) > 0 && isDeletionProtected(d, meta) {
+		sort.Strings(removedEmails)
+		return saveProgress(diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%d users removed from the users file are protected from deletion", len(removedEmails)),
+			Detail: fmt.Sprintf("Removed users: %s. To delete them, set deletion_protection = false on the resource. "+
+				"If deletion_protection is not set on the resource, it is enabled by the provider's deletion_protection setting.", strings.Join(removedEmails, ", ")),
+		}})
+	}
+
+	// Delete users removed from the file
+	for _, email := range removedEmails {
+		if diagErr := deleteBulkUser(email, userIDs[email], usersAPI); diagErr != nil {
+			return saveProgress(diagErr)
+		}
+		delete(userIDs, email)
+	}
+
+	// Create new users before updating managers, which may be other new users
+	var changedUsers []bulkUser
+	createdUsers := make(map[string]bool)
+	for _, user := range users {
+		if _, found := userIDs[user.Email]; found {
+			if oldHashes.(map[string]interface{})[user.Email] != rowHashes[user.Email] {
+				changedUsers = append(changedUsers, user)
+			} else {
+				appliedUsers[user.Email] = true
+			}
+			continue
+		}
+
+		id, created, diagErr := createBulkUser(user, columns, lookups, meta, usersAPI)
+		if diagErr != nil {
+			return saveProgress(diagErr)
+		}
+		userIDs[user.Email] = id
+		createdUsers[user.Email] = created
+		changedUsers = append(changedUsers, user)
+	}
+	log.Printf("Updating %d bulk users %s", len(changedUsers), d.Id())
+
+	managerIDs, diagErr := getBulkUserManagerIDs(changedUsers, columns, userIDs, usersAPI)
+	if diagErr != nil {
+		return saveProgress(diagErr)
+	}
+
+	divisionMoves := make(map[string][]string)
+	for _, user := range changedUsers {
+		userID := userIDs[user.Email]
+		if diagErr := updateBulkUser(user, userID, columns, managerIDs[user.ManagerEmail], lookups, usersAPI); diagErr != nil {
+			return saveProgress(diagErr)
+		}
+		// New users are created in their division. Other users are applied once they are moved.
+		if stringInSlice(bulkUserColumnDivision, columns) && !createdUsers[user.Email] {
+			divisionID := lookups.divisions.ids[user.Division]
+			divisionMoves[divisionID] = append(divisionMoves[divisionID], userID)
+		} else {
+			appliedUsers[user.Email] = true
+		}
+	}
+
+	emailsByID := make(map[string]string, len(userIDs))
+	for email, id := range userIDs {
+		emailsByID[id] = email
+	}
+	for divisionID, ids := range divisionMoves {
+		for _, chunk := range chunkBulkUserIDs(ids) {
+			log.Printf("Moving %d users to division %s", len(chunk), divisionID)
+			_, err := authAPI.PostAuthorizationDivisionObject(divisionID, "USER", chunk)
+			if err != nil {
+				return saveProgress(diag.Errorf("Failed to update division for users: %s", err))
+			}
+			for _, id := range chunk {
+				appliedUsers[emailsByID[id]] = true
+			}
+		}
+	}
+
+	// State is set from the file so it matches the plan. Differences from the API are detected on the next read.
+	saveProgress(nil)
+	log.Printf("Updated %d bulk users %s", len(changedUsers), d.Id())
+	return nil
+}
+
+// setBulkUsersProgress sets the IDs and row hashes of the managed users. Users that have been applied have the hash from the file.
+// Other users keep their previous hash, or have an empty hash if they were created but not updated, so they are changed again on the next apply.
+func setBulkUsersProgress(d *schema.ResourceData, userIDs map[string]string, appliedUsers map[string]bool, rowHashes map[string]interface{}, oldHashes map[string]interface{}, columns []string) {
+	stateIDs := make(map[string]interface{}, len(userIDs))
+	stateHashes := make(map[string]interface{}, len(userIDs))
+	for email, id := range userIDs {
+		stateIDs[email] = id
+		if appliedUsers[email] {
+			stateHashes[email] = rowHashes[email]
+		} else if oldHash, found := oldHashes[email]; found {
+			stateHashes[email] = oldHash
+		} else {
+			stateHashes[email] = ""
+		}
+	}
+	d.Set("users", stateIDs)
+	d.Set("row_hashes", stateHashes)
+	d.Set("managed_columns", columns)
+	d.Set("content_hash", bulkUsersContentHash(stateHashes))
+}
+
+func deleteUsersBulk(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usersAPI := meta.(*providerMeta).usersProxy()
+
+	for email, id := range d.Get("users").(map[string]interface{}) {
+		if diagErr := deleteBulkUser(email, id.(string), usersAPI); diagErr != nil {
+			return diagErr
+		}
+	}
+	log.Printf("Deleted bulk users %s", d.Id())
+	return nil
+}
+
+func loadBulkUsers(d bulkUsersSource) ([]bulkUser, []string, error) {
+	content := d.Get("content").(string)
+	format := d.Get("format").(string)
+	if sourceFile := d.Get("source_file").(string); sourceFile != "" {
+		data, err := ioutil.ReadFile(sourceFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Failed to read users file %s: %v", sourceFile, err)
+		}
+		content = string(data)
+		if format == "" && strings.EqualFold(filepath.Ext(sourceFile), ".json") {
+			format = bulkUsersFormatJSON
+		}
+	} else if format == "" && strings.HasPrefix(strings.TrimSpace(content), "[") {
+		format = bulkUsersFormatJSON
+	}
+
+	parse := parseBulkUsersCSV
+	if format == bulkUsersFormatJSON {
+		parse = parseBulkUsersJSON
+	}
+	users, columns, err := parse(content)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(users) == 0 && !d.Get("allow_empty").(bool) {
+		// An empty file deletes every managed user, which is more likely a mistake than intended
+		return nil, nil, fmt.Errorf("Users file has no users. Set allow_empty = true to delete all managed users")
+	}
+	return users, columns, nil
+}
+
+// parseBulkUsersCSV parses users from a CSV with a header row. Skills and languages are lists of
+// name:proficiency separated by semicolons, e.g. "Sales:3;Support:4.5".
+func parseBulkUsersCSV(content string) ([]bulkUser, []string, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to parse users CSV: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("Users CSV must have a header row")
+	}
+
+	header := make([]string, len(records[0]))
+	for i, column := range records[0] {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+		if !stringInSlice(header[i], bulkUserColumns) {
+			return nil, nil, fmt.Errorf("Unknown column %s in users CSV. Expected one of %s", column, strings.Join(bulkUserColumns, ", "))
+		}
+	}
+
+	users := make([]bulkUser, 0, len(records)-1)
+	for rowNum, record := range records[1:] {
+		row := make(map[string]string, len(record))
+		for i, value := range record {
+			row[header[i]] = strings.TrimSpace(value)
+		}
+
+		skills, err := parseBulkUserProficiencies(row[bulkUserColumnSkills])
+		if err != nil {
+			return nil, nil, fmt.Errorf("Invalid skills in row %d of users CSV: %v", rowNum+2, err)
+		}
+		languageProfs, err := parseBulkUserProficiencies(row[bulkUserColumnLanguages])
+		if err != nil {
+			return nil, nil, fmt.Errorf("Invalid languages in row %d of users CSV: %v", rowNum+2, err)
+		}
+		languages, err := bulkUserLanguageProficiencies(languageProfs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Invalid languages in row %d of users CSV: %v", rowNum+2, err)
+		}
+
+		users = append(users, bulkUser{
+			Email:        row[bulkUserColumnEmail],
+			Name:         row[bulkUserColumnName],
+			Division:     row[bulkUserColumnDivision],
+			ManagerEmail: row[bulkUserColumnManagerEmail],
+			Skills:       skills,
+			Languages:    languages,
+		})
+	}
+	return normalizeBulkUsers(users, header)
+}
+
+// parseBulkUsersJSON parses users from a JSON array. A column is managed if it is set for any user.
+func parseBulkUsersJSON(content string) ([]bulkUser, []string, error) {
+	var jsonUsers []bulkUserJSON
+	decoder := json.NewDecoder(strings.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&jsonUsers); err != nil {
+		return nil, nil, fmt.Errorf("Failed to parse users JSON: %v", err)
+	}
+
+	columns := []string{bulkUserColumnEmail, bulkUserColumnName}
+	users := make([]bulkUser, len(jsonUsers))
+	for i, jsonUser := range jsonUsers {
+		users[i] = bulkUser{
+			Email: jsonUser.Email,
+			Name:  jsonUser.Name,
+		}
+		if jsonUser.Division != nil {
+			users[i].Division = *jsonUser.Division
+			columns = append(columns, bulkUserColumnDivision)
+		}
+		if jsonUser.ManagerEmail != nil {
+			users[i].ManagerEmail = *jsonUser.ManagerEmail
+			columns = append(columns, bulkUserColumnManagerEmail)
+		}
+		if jsonUser.Skills != nil {
+			users[i].Skills = *jsonUser.Skills
+			columns = append(columns, bulkUserColumnSkills)
+		}
+		if jsonUser.Languages != nil {
+			users[i].Languages = *jsonUser.Languages
+			columns = append(columns, bulkUserColumnLanguages)
+		}
+	}
+	return normalizeBulkUsers(users, columns)
+}
+
+func parseBulkUserProficiencies(value string) (map[string]float64, error) {
+	profs := make(map[string]float64)
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		separator := strings.LastIndex(entry, ":")
+		if separator < 1 {
+			return nil, fmt.Errorf("%s is not in the format name:proficiency", entry)
+		}
+		prof, err := strconv.ParseFloat(strings.TrimSpace(entry[separator+1:]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proficiency in %s", entry)
+		}
+		profs[strings.TrimSpace(entry[:separator])] = prof
+	}
+	return profs, nil
+}
+
+func bulkUserLanguageProficiencies(profs map[string]float64) (map[string]int, error) {
+	languages := make(map[string]int, len(profs))
+	for name, prof := range profs {
+		if prof != float64(int(prof)) {
+			return nil, fmt.Errorf("proficiency for %s must be a whole number", name)
+		}
+		languages[name] = int(prof)
+	}
+	return languages, nil
+}
+
+// normalizeBulkUsers validates users and converts them to the form compared to users read from the API
+func normalizeBulkUsers(users []bulkUser, columns []string) ([]bulkUser, []string, error) {
+	if !stringInSlice(bulkUserColumnEmail, columns) || !stringInSlice(bulkUserColumnName, columns) {
+		return nil, nil, fmt.Errorf("Users must have %s and %s columns", bulkUserColumnEmail, bulkUserColumnName)
+	}
+
+	emails := make(map[string]bool, len(users))
+	for i, user := range users {
+		user.Email = strings.ToLower(strings.TrimSpace(user.Email))
+		user.Name = strings.TrimSpace(user.Name)
+		user.Division = strings.ToLower(strings.TrimSpace(user.Division))
+		user.ManagerEmail = strings.ToLower(strings.TrimSpace(user.ManagerEmail))
+		if user.Email == "" || user.Name == "" {
+			return nil, nil, fmt.Errorf("User %d is missing an email or name", i+1)
+		}
+		if emails[user.Email] {
+			return nil, nil, fmt.Errorf("User %s is listed more than once", user.Email)
+		}
+		emails[user.Email] = true
+
+		skills := make(map[string]float64, len(user.Skills))
+		for name, prof := range user.Skills {
+			if prof < 0 || prof > 5 {
+				return nil, nil, fmt.Errorf("Proficiency for skill %s of user %s must be between 0 and 5", name, user.Email)
+			}
+			skills[strings.ToLower(strings.TrimSpace(name))] = prof
+		}
+		user.Skills = skills
+
+		languages := make(map[string]int, len(user.Languages))
+		for name, prof := range user.Languages {
+			if prof < 0 || prof > 5 {
+				return nil, nil, fmt.Errorf("Proficiency for language %s of user %s must be between 0 and 5", name, user.Email)
+			}
+			languages[strings.ToLower(strings.TrimSpace(name))] = prof
+		}
+		user.Languages = languages
+
+		users[i] = user
+	}
+
+	// Columns are listed once in a consistent order
+	var managedColumns []string
+	for _, column := range bulkUserColumns {
+		if stringInSlice(column, columns) {
+			managedColumns = append(managedColumns, column)
+		}
+	}
+	return users, managedColumns, nil
+}
+
+// bulkUserHash hashes the attributes of a user in the managed columns
+func bulkUserHash(user bulkUser, columns []string) string {
+	managed := bulkUser{
+		Email: user.Email,
+		Name:  user.Name,
+	}
+	if stringInSlice(bulkUserColumnDivision, columns) {
+		managed.Division = user.Division
+	}
+	if stringInSlice(bulkUserColumnManagerEmail, columns) {
+		managed.ManagerEmail = user.ManagerEmail
+	}
+	if stringInSlice(bulkUserColumnSkills, columns) {
+		managed.Skills = user.Skills
+	}
+	if stringInSlice(bulkUserColumnLanguages, columns) {
+		managed.Languages = user.Languages
+	}
+
+	// Maps are marshalled with sorted keys
+	data, _ := json.Marshal(managed)
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+func bulkUserRowHashes(users []bulkUser, columns []string) map[string]interface{} {
+	rowHashes := make(map[string]interface{}, len(users))
+	for _, user := range users {
+		rowHashes[user.Email] = bulkUserHash(user, columns)
+	}
+	return rowHashes
+}
+
+func bulkUsersContentHash(rowHashes map[string]interface{}) string {
+	emails := make([]string, 0, len(rowHashes))
+	for email := range rowHashes {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	var content bytes.Buffer
+	for _, email := range emails {
+		fmt.Fprintf(&content, "%s:%v\n", email, rowHashes[email])
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(content.Bytes()))
+}
+
+func countChangedBulkUsers(oldHashes map[string]interface{}, newHashes map[string]interface{}) int {
+	changed := 0
+	for email, hash := range newHashes {
+		if oldHashes[email] != hash {
+			changed++
+		}
+	}
+	for email := range oldHashes {
+		if _, found := newHashes[email]; !found {
+			changed++
+		}
+	}
+	return changed
+}
+
+func flattenBulkUser(apiUser platformclientv2.User, managerEmails map[string]string, divisions *bulkUserDivisions) bulkUser {
+	user := bulkUser{
+		Skills:    make(map[string]float64),
+		Languages: make(map[string]int),
+	}
+	if apiUser.Email != nil {
+		user.Email = strings.ToLower(*apiUser.Email)
+	}
+	if apiUser.Name != nil {
+		user.Name = *apiUser.Name
+	}
+	if divisions != nil && apiUser.Division != nil && apiUser.Division.Id != nil && *apiUser.Division.Id != divisions.homeID {
+		user.Division = divisions.names[*apiUser.Division.Id]
+	}
+	if apiUser.Manager != nil && (*apiUser.Manager).Id != nil {
+		user.ManagerEmail = managerEmails[*(*apiUser.Manager).Id]
+	}
+	if apiUser.Skills != nil {
+		for _, skill := range *apiUser.Skills {
+			if skill.Name != nil && skill.Proficiency != nil {
+				user.Skills[strings.ToLower(*skill.Name)] = *skill.Proficiency
+			}
+		}
+	}
+	if apiUser.Languages != nil {
+		for _, language := range *apiUser.Languages {
+			if language.Name != nil && language.Proficiency != nil {
+				user.Languages[strings.ToLower(*language.Name)] = int(*language.Proficiency)
+			}
+		}
+	}
+	return user
+}
+
+// createBulkUser creates a user and returns its ID. Deleted users with the same email are restored, and existing
+// users are adopted if the provider's adopt_existing setting is enabled. Returns false if an existing user was used.
+func createBulkUser(user bulkUser, columns []string, lookups *bulkUserLookups, meta interface{}, usersAPI usersProxy) (string, bool, diag.Diagnostics) {
+	createUser := platformclientv2.Createuser{
+		Email: &user.Email,
+		Name:  &user.Name,
+	}
+	if stringInSlice(bulkUserColumnDivision, columns) {
+		divisionID := lookups.divisions.ids[user.Division]
+		createUser.DivisionId = &divisionID
+	}
+
+	log.Printf("Creating user %s", user.Email)
+	created, resp, err := usersAPI.PostUsers(createUser)
+	if err == nil {
+		return *created.Id, true, nil
+	}
+	if resp == nil || resp.Error == nil || (*resp.Error).Code != "general.conflict" {
+		return "", false, diag.Errorf("Failed to create user %s: %s", user.Email, err)
+	}
+
+	id, diagErr := getDeletedUserId(user.Email, usersAPI)
+	if diagErr != nil {
+		return "", false, diagErr
+	}
+	if id != nil {
+		log.Printf("Restoring deleted user %s", user.Email)
+		active := "active"
+		if diagErr := patchUserWithState(*id, "deleted", platformclientv2.Updateuser{State: &active}, usersAPI); diagErr != nil {
+			return "", false, diagErr
+		}
+		return *id, false, nil
+	}
+
+	if m, ok := meta.(*providerMeta); ok && m.AdoptExisting {
+		existingIDs, diagErr := getBulkUserIDsByEmail([]string{user.Email}, usersAPI)
+		if diagErr != nil {
+			return "", false, diagErr
+		}
+		if existingID, found := existingIDs[user.Email]; found {
+			log.Printf("Adopting existing user %s", user.Email)
+			return existingID, false, nil
+		}
+	}
+	return "", false, diag.Errorf("Failed to create user %s: %s", user.Email, err)
+}
+
+// updateBulkUser updates all managed attributes of a user except its division, which is updated in batches
+func updateBulkUser(user bulkUser, userID string, columns []string, managerID string, lookups *bulkUserLookups, usersAPI usersProxy) diag.Diagnostics {
+	update := platformclientv2.Updateuser{
+		Email: &user.Email,
+		Name:  &user.Name,
+	}
+	if stringInSlice(bulkUserColumnManagerEmail, columns) {
+		update.Manager = &managerID
+	}
+	if diagErr := patchUser(userID, update, usersAPI); diagErr != nil {
+		return diagErr
+	}
+
+	if stringInSlice(bulkUserColumnSkills, columns) {
+		sdkSkills := make([]platformclientv2.Userroutingskillpost, 0, len(user.Skills))
+		for name, prof := range user.Skills {
+			skillID := lookups.skillIDs[name]
+			skillProf := prof
+			sdkSkills = append(sdkSkills, platformclientv2.Userroutingskillpost{
+				Id:          &skillID,
+				Proficiency: &skillProf,
+			})
+		}
+		diagErr := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			_, resp, err := usersAPI.PutUserRoutingskillsBulk(userID, sdkSkills)
+			if err != nil {
+				return resp, diag.Errorf("Failed to update skills for user %s: %s", user.Email, err)
+			}
+			return nil, nil
+		})
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	if stringInSlice(bulkUserColumnLanguages, columns) {
+		langProfs := make(map[string]int, len(user.Languages))
+		langIDs := make([]string, 0, len(user.Languages))
+		for name, prof := range user.Languages {
+			langID := lookups.languageIDs[name]
+			langProfs[langID] = prof
+			langIDs = append(langIDs, langID)
+		}
+
+		oldLangs, diagErr := getUserRoutingLanguages(userID, usersAPI)
+		if diagErr != nil {
+			return diagErr
+		}
+		for _, lang := range oldLangs {
+			if lang.Id == nil {
+				continue
+			}
+			if _, found := langProfs[*lang.Id]; found {
+				continue
+			}
+			langID := *lang.Id
+			diagErr := retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+				resp, err := usersAPI.DeleteUserRoutinglanguage(userID, langID)
+				if err != nil {
+					return resp, diag.Errorf("Failed to remove language from user %s: %s", user.Email, err)
+				}
+				return nil, nil
+			})
+			if diagErr != nil {
+				return diagErr
+			}
+		}
+		if diagErr := updateUserRoutingLanguages(userID, langIDs, langProfs, usersAPI); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
+}
+
+func deleteBulkUser(email string, userID string, usersAPI usersProxy) diag.Diagnostics {
+	log.Printf("Deleting user %s", email)
+	return retryWhen(isVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		_, resp, err := usersAPI.DeleteUser(userID)
+		if err != nil && !isStatus404(resp) {
+			return resp, diag.Errorf("Failed to delete user %s: %s", email, err)
+		}
+		return nil, nil
+	})
+}
+
+// getBulkUsersByID gets active users in batches, keyed by ID
+func getBulkUsersByID(ids []string, expand []string, usersAPI usersProxy) (map[string]platformclientv2.User, diag.Diagnostics) {
+	users := make(map[string]platformclientv2.User, len(ids))
+	for _, chunk := range chunkBulkUserIDs(ids) {
+		userList, _, err := usersAPI.GetUsers(bulkUsersBatchSize, 1, chunk, nil, "", expand, "", "")
+		if err != nil {
+			return nil, diag.Errorf("Failed to get users: %s", err)
+		}
+		if userList.Entities == nil {
+			continue
+		}
+		requested := make(map[string]bool, len(chunk))
+		for _, id := range chunk {
+			requested[id] = true
+		}
+		for _, user := range *userList.Entities {
+			if user.Id == nil || !requested[*user.Id] || (user.State != nil && *user.State == "deleted") {
+				continue
+			}
+			users[*user.Id] = user
+		}
+	}
+	return users, nil
+}
+
+// getBulkUserIDsByEmail searches for active users in batches and returns their IDs keyed by lowercase email
+func getBulkUserIDsByEmail(emails []string, usersAPI usersProxy) (map[string]string, diag.Diagnostics) {
+	exactType := "EXACT"
+	pageSize := bulkUsersBatchSize
+	ids := make(map[string]string, len(emails))
+	for _, chunk := range chunkBulkUserIDs(emails) {
+		values := chunk
+		results, _, err := usersAPI.PostUsersSearch(platformclientv2.Usersearchrequest{
+			PageSize: &pageSize,
+			Query: &[]platformclientv2.Usersearchcriteria{
+				{
+					Fields:  &[]string{"email"},
+					Values:  &values,
+					VarType: &exactType,
+				},
+			},
+		})
+		if err != nil {
+			return nil, diag.Errorf("Failed to search for users by email: %s", err)
+		}
+		if results.Results == nil {
+			continue
+		}
+		for _, user := range *results.Results {
+			if user.Id != nil && user.Email != nil {
+				ids[strings.ToLower(*user.Email)] = *user.Id
+			}
+		}
+	}
+	return ids, nil
+}
+
+// getBulkUserManagerIDs returns the IDs of the managers of users keyed by email. Managers that are not in the users
+// file are searched for by email.
+func getBulkUserManagerIDs(users []bulkUser, columns []string, userIDs map[string]string, usersAPI usersProxy) (map[string]string, diag.Diagnostics) {
+	managerIDs := map[string]string{"": ""}
+	if !stringInSlice(bulkUserColumnManagerEmail, columns) {
+		return managerIDs, nil
+	}
+
+	var unknownEmails []string
+	for _, user := range users {
+		if _, found := managerIDs[user.ManagerEmail]; found {
+			continue
+		}
+		if id, found := userIDs[user.ManagerEmail]; found {
+			managerIDs[user.ManagerEmail] = id
+		} else if !stringInSlice(user.ManagerEmail, unknownEmails) {
+			unknownEmails = append(unknownEmails, user.ManagerEmail)
+		}
+	}
+
+	foundIDs, diagErr := getBulkUserIDsByEmail(unknownEmails, usersAPI)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+	for _, email := range unknownEmails {
+		id, found := foundIDs[email]
+		if !found {
+			return nil, diag.Errorf("Manager %s not found", email)
+		}
+		managerIDs[email] = id
+	}
+	return managerIDs, nil
+}
+
+func chunkBulkUserIDs(ids []string) [][]string {
+	var chunks [][]string
+	for i := 0; i < len(ids); i += bulkUsersBatchSize {
+		end := i + bulkUsersBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunks = append(chunks, ids[i:end])
+	}
+	return chunks
+}
+
+type bulkUserDivisions struct {
+	homeID string
+	// Lowercase names keyed by ID
+	names map[string]string
+	// IDs keyed by lowercase name. The empty name is the home division.
+	ids map[string]string
+}
+
+type bulkUserLookups struct {
+	divisions   *bulkUserDivisions
+	skillIDs    map[string]string
+	languageIDs map[string]string
+}
+
+// getBulkUserLookups gets the IDs of the divisions, skills, and languages in the managed columns by lowercase name
+func getBulkUserLookups(columns []string, sdkConfig *platformclientv2.Configuration) (*bulkUserLookups, diag.Diagnostics) {
+	lookups := &bulkUserLookups{}
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+	if stringInSlice(bulkUserColumnDivision, columns) {
+		divisions, diagErr := getBulkUserDivisions(platformclientv2.NewAuthorizationApiWithConfig(sdkConfig))
+		if diagErr != nil {
+			return nil, diagErr
+		}
+		lookups.divisions = divisions
+	}
+
+	if stringInSlice(bulkUserColumnSkills, columns) {
+		lookups.skillIDs = make(map[string]string)
+		for pageNum := 1; ; pageNum++ {
+			skills, _, err := routingAPI.GetRoutingSkills(100, pageNum, "", nil)
+			if err != nil {
+				return nil, diag.Errorf("Failed to get skills: %s", err)
+			}
+			if skills.Entities == nil || len(*skills.Entities) == 0 {
+				break
+			}
+			for _, skill := range *skills.Entities {
+				if skill.State != nil && *skill.State == "deleted" {
+					continue
+				}
+				lookups.skillIDs[strings.ToLower(*skill.Name)] = *skill.Id
+			}
+		}
+	}
+
+	if stringInSlice(bulkUserColumnLanguages, columns) {
+		lookups.languageIDs = make(map[string]string)
+		for pageNum := 1; ; pageNum++ {
+			languages, _, err := routingAPI.GetRoutingLanguages(100, pageNum, "", "", nil)
+			if err != nil {
+				return nil, diag.Errorf("Failed to get languages: %s", err)
+			}
+			if languages.Entities == nil || len(*languages.Entities) == 0 {
+				break
+			}
+			for _, language := range *languages.Entities {
+				if language.State != nil && *language.State == "deleted" {
+					continue
+				}
+				lookups.languageIDs[strings.ToLower(*language.Name)] = *language.Id
+			}
+		}
+	}
+	return lookups, nil
+}
+
+// validateBulkUserLookups checks that all divisions, skills, and languages exist before any users are changed
+func validateBulkUserLookups(users []bulkUser, lookups *bulkUserLookups) diag.Diagnostics {
+	for _, user := range users {
+		if lookups.divisions != nil {
+			if _, found := lookups.divisions.ids[user.Division]; !found {
+				return diag.Errorf("Division %s of user %s not found", user.Division, user.Email)
+			}
+			if user.Division != "" && lookups.divisions.ids[user.Division] == lookups.divisions.homeID {
+				// Users in the home division are read with an empty division
+				return diag.Errorf("Division of user %s must be empty to use the home division", user.Email)
+			}
+		}
+		for name := range user.Skills {
+			if _, found := lookups.skillIDs[name]; !found {
+				return diag.Errorf("Skill %s of user %s not found", name, user.Email)
+			}
+		}
+		for name := range user.Languages {
+			if _, found := lookups.languageIDs[name]; !found {
+				return diag.Errorf("Language %s of user %s not found", name, user.Email)
+			}
+		}
+	}
+	return nil
+}
+
+func getBulkUserDivisions(authAPI *platformclientv2.AuthorizationApi) (*bulkUserDivisions, diag.Diagnostics) {
+	divisions := &bulkUserDivisions{
+		names: make(map[string]string),
+		ids:   make(map[string]string),
+	}
+	for pageNum := 1; ; pageNum++ {
+		divisionList, _, err := authAPI.GetAuthorizationDivisions(100, pageNum, "", nil, "", "", false, nil, "")
+		if err != nil {
+			return nil, diag.Errorf("Failed to get divisions: %s", err)
+		}
+		if divisionList.Entities == nil || len(*divisionList.Entities) == 0 {
+			break
+		}
+		for _, division := range *divisionList.Entities {
+			name := strings.ToLower(*division.Name)
+			divisions.names[*division.Id] = name
+			divisions.ids[name] = *division.Id
+			if division.HomeDivision != nil && *division.HomeDivision {
+				divisions.homeID = *division.Id
+				divisions.ids[""] = *division.Id
+			}
+		}
+	}
+	return divisions, nil
+}
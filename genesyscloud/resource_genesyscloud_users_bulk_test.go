@@ -0,0 +1,395 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+func TestAccResourceUsersBulk(t *testing.T) {
+	var (
+		bulkResource  = "test-users-bulk"
+		bulkFullName  = "genesyscloud_users_bulk." + bulkResource
+		skillResource = "test-bulk-skill"
+		skillName     = "Terraform Bulk Skill-" + uuid.NewString()
+		managerEmail  = "terraform-bulk-manager-" + uuid.NewString() + "@example.com"
+		agentEmail    = "terraform-bulk-agent-" + uuid.NewString() + "@example.com"
+		usersDir      = t.TempDir()
+		usersFile     = filepath.Join(usersDir, "users.csv")
+	)
+
+	writeUsersFile := func(content string) func() {
+		return func() {
+			if err := ioutil.WriteFile(usersFile, []byte(content), 0644); err != nil {
+				t.Fatalf("Failed to write users file: %v", err)
+			}
+		}
+	}
+	config := generateRoutingSkillResource(skillResource, skillName) + generateUsersBulkResource(bulkResource, usersFile, "genesyscloud_routing_skill."+skillResource)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				PreConfig: writeUsersFile(fmt.Sprintf("email,name,manager_email,skills\n%s,Bulk Manager,,\n%s,Bulk Agent,%s,%s:3\n", managerEmail, agentEmail, managerEmail, skillName)),
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(bulkFullName, "users.%", "2"),
+					resource.TestCheckResourceAttr(bulkFullName, "row_hashes.%", "2"),
+					resource.TestCheckResourceAttr(bulkFullName, "managed_columns.#", "4"),
+					resource.TestCheckResourceAttrSet(bulkFullName, "content_hash"),
+				),
+			},
+			{
+				// Remove a user and rename the other
+				PreConfig: writeUsersFile(fmt.Sprintf("email,name,manager_email,skills\n%s,Bulk Manager Renamed,,\n", managerEmail)),
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(bulkFullName, "users.%", "1"),
+					resource.TestCheckResourceAttrSet(bulkFullName, "users."+managerEmail),
+					resource.TestCheckNoResourceAttr(bulkFullName, "users."+agentEmail),
+				),
+			},
+		},
+		CheckDestroy: testVerifyUsersDestroyed,
+	})
+}
+
+func generateUsersBulkResource(resourceID string, sourceFile string, dependsOn string) string {
+	return fmt.Sprintf(`resource "genesyscloud_users_bulk" "%s" {
+		source_file = "%s"
+		depends_on = [%s]
+	}
+	`, resourceID, sourceFile, dependsOn)
+}
+
+func TestUnitUsersBulkParse(t *testing.T) {
+	csvUsers, csvColumns, err := parseBulkUsersCSV("Email,Name,Division,Skills,Languages\n" +
+		"Agent@Example.com, Agent One ,Sales,Sales:3;Support:4.5,English:4\n" +
+		"other@example.com,Other Agent,,,\n")
+	if err != nil {
+		t.Fatalf("Failed to parse users CSV: %v", err)
+	}
+	jsonUsers, jsonColumns, err := parseBulkUsersJSON(`[
+		{"email": "agent@example.com", "name": "Agent One", "division": "sales", "skills": {"sales": 3, "Support": 4.5}, "languages": {"English": 4}},
+		{"email": "other@example.com", "name": "Other Agent", "division": "", "skills": {}, "languages": {}}
+	]`)
+	if err != nil {
+		t.Fatalf("Failed to parse users JSON: %v", err)
+	}
+
+	expectedColumns := []string{"email", "name", "division", "skills", "languages"}
+	if strings.Join(csvColumns, ",") != strings.Join(expectedColumns, ",") || strings.Join(jsonColumns, ",") != strings.Join(expectedColumns, ",") {
+		t.Errorf("Expected columns %v, got %v and %v", expectedColumns, csvColumns, jsonColumns)
+	}
+	if csvUsers[0].Email != "agent@example.com" || csvUsers[0].Name != "Agent One" || csvUsers[0].Skills["support"] != 4.5 || csvUsers[0].Languages["english"] != 4 {
+		t.Errorf("Unexpected user parsed from CSV: %+v", csvUsers[0])
+	}
+
+	// Equivalent CSV and JSON users have the same hashes
+	csvHashes := bulkUserRowHashes(csvUsers, csvColumns)
+	jsonHashes := bulkUserRowHashes(jsonUsers, jsonColumns)
+	if bulkUsersContentHash(csvHashes) != bulkUsersContentHash(jsonHashes) {
+		t.Errorf("Expected CSV hashes %v to match JSON hashes %v", csvHashes, jsonHashes)
+	}
+
+	// Only managed columns change the hash
+	withManager := csvUsers[1]
+	withManager.ManagerEmail = "manager@example.com"
+	if bulkUserHash(withManager, csvColumns) != csvHashes["other@example.com"] {
+		t.Error("Expected unmanaged columns not to change the hash")
+	}
+	renamed := csvUsers[1]
+	renamed.Name = "Renamed"
+	if bulkUserHash(renamed, csvColumns) == csvHashes["other@example.com"] {
+		t.Error("Expected a changed name to change the hash")
+	}
+
+	invalidContent := map[string]string{
+		"unknown column":        "email,name,title\na@example.com,A,Agent\n",
+		"missing name column":   "email\na@example.com\n",
+		"duplicate email":       "email,name\na@example.com,A\nA@example.com,B\n",
+		"invalid proficiency":   "email,name,skills\na@example.com,A,Sales:high\n",
+		"proficiency too high":  "email,name,skills\na@example.com,A,Sales:6\n",
+		"fractional language":   "email,name,languages\na@example.com,A,English:2.5\n",
+		"missing email":         "email,name\n,A\n",
+		"unknown JSON property": `[{"email": "a@example.com", "name": "A", "title": "Agent"}]`,
+	}
+	for description, content := range invalidContent {
+		parse := parseBulkUsersCSV
+		if strings.HasPrefix(content, "[") {
+			parse = parseBulkUsersJSON
+		}
+		if _, _, err := parse(content); err == nil {
+			t.Errorf("%s: expected an error parsing %s", description, content)
+		}
+	}
+}
+
+func TestUnitUsersBulk(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	ctx := context.Background()
+	bulkResource := resourceUsersBulk()
+
+	division := fakeAPI.create("/api/v2/authorization/divisions", map[string]interface{}{"name": "Agents"})
+	salesSkill := fakeAPI.create("/api/v2/routing/skills", map[string]interface{}{"name": "Sales"})
+	supportSkill := fakeAPI.create("/api/v2/routing/skills", map[string]interface{}{"name": "Support"})
+	english := fakeAPI.create("/api/v2/routing/languages", map[string]interface{}{"name": "English"})
+	outsideManager := fakeAPI.create("/api/v2/users", map[string]interface{}{"name": "Outside Manager", "email": "outside@example.com"})
+
+	usersFile := filepath.Join(t.TempDir(), "users.csv")
+	err := ioutil.WriteFile(usersFile, []byte("email,name,division,manager_email,skills,languages\n"+
+		"manager@example.com,Manager,,outside@example.com,,English:4\n"+
+		"agent@example.com,Agent,Agents,manager@example.com,Sales:2;Support:4.5,\n"), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write users file: %v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, bulkResource.Schema, map[string]interface{}{
+		"source_file": usersFile,
+	})
+	if diagErr := createUsersBulk(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to create bulk users: %v", diagErr)
+	}
+
+	userIDs := d.Get("users").(map[string]interface{})
+	managerID, _ := userIDs["manager@example.com"].(string)
+	agentID, _ := userIDs["agent@example.com"].(string)
+	manager := fakeAPI.get("/api/v2/users", managerID)
+	agent := fakeAPI.get("/api/v2/users", agentID)
+	if manager == nil || agent == nil {
+		t.Fatalf("Expected users to be created, got %v", userIDs)
+	}
+	if agentDivision, _ := agent["division"].(map[string]interface{}); agentDivision["id"] != division["id"] {
+		t.Errorf("Expected agent in division %v, got %v", division["id"], agent["division"])
+	}
+	if agentManager, _ := agent["manager"].(map[string]interface{}); agentManager["id"] != managerID {
+		t.Errorf("Expected agent's manager %s, got %v", managerID, agent["manager"])
+	}
+	if managersManager, _ := manager["manager"].(map[string]interface{}); managersManager["id"] != outsideManager["id"] {
+		t.Errorf("Expected manager's manager %v, got %v", outsideManager["id"], manager["manager"])
+	}
+
+	skills, _ := fakeAPI.subResources["/api/v2/users/"+agentID+"/routingskills/bulk"].(map[string]interface{})
+	if skillList, _ := skills["entities"].([]interface{}); len(skillList) != 2 {
+		t.Errorf("Expected 2 skills to be set for the agent, got %v", skills)
+	}
+	languages, _ := fakeAPI.subResources["/api/v2/users/"+managerID+"/routinglanguages/bulk"].(map[string]interface{})
+	if languageList, _ := languages["entities"].([]interface{}); len(languageList) != 1 {
+		t.Errorf("Expected 1 language to be set for the manager, got %v", languages)
+	}
+
+	// Reading users that match the file doesn't change the hashes
+	fakeAPI.update("/api/v2/users", agentID, map[string]interface{}{"skills": []interface{}{
+		map[string]interface{}{"id": salesSkill["id"], "name": "Sales", "proficiency": 2},
+		map[string]interface{}{"id": supportSkill["id"], "name": "Support", "proficiency": 4.5},
+	}})
+	fakeAPI.update("/api/v2/users", managerID, map[string]interface{}{"languages": []interface{}{
+		map[string]interface{}{"id": english["id"], "name": "English", "proficiency": 4},
+	}})
+	contentHash := d.Get("content_hash").(string)
+	if diagErr := readUsersBulk(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to read bulk users: %v", diagErr)
+	}
+	if d.Get("content_hash") != contentHash {
+		t.Errorf("Expected content hash %s after read, got %s with row hashes %v", contentHash, d.Get("content_hash"), d.Get("row_hashes"))
+	}
+
+	// Changes outside of Terraform are detected for the changed user
+	rowHashes := d.Get("row_hashes").(map[string]interface{})
+	fakeAPI.update("/api/v2/users", agentID, map[string]interface{}{"name": "Renamed Agent"})
+	if diagErr := readUsersBulk(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to read bulk users: %v", diagErr)
+	}
+	newRowHashes := d.Get("row_hashes").(map[string]interface{})
+	if d.Get("content_hash") == contentHash || newRowHashes["agent@example.com"] == rowHashes["agent@example.com"] || newRowHashes["manager@example.com"] != rowHashes["manager@example.com"] {
+		t.Errorf("Expected only the agent's hash to change, got %v", d.Get("row_hashes"))
+	}
+
+	if diagErr := deleteUsersBulk(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to delete bulk users: %v", diagErr)
+	}
+	for _, id := range []string{managerID, agentID} {
+		if user := fakeAPI.get("/api/v2/users", id); user["state"] != "deleted" {
+			t.Errorf("Expected user %s to be deleted, got %v", id, user["state"])
+		}
+	}
+	if fakeAPI.get("/api/v2/users", outsideManager["id"].(string))["state"] != "active" {
+		t.Error("Expected users not in the file not to be deleted")
+	}
+
+	// Deleted users are removed from state
+	if diagErr := readUsersBulk(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to read bulk users: %v", diagErr)
+	}
+	if len(d.Get("users").(map[string]interface{})) != 0 {
+		t.Errorf("Expected deleted users to be removed from state, got %v", d.Get("users"))
+	}
+}
+
+func TestUnitUsersBulkDiff(t *testing.T) {
+	ctx := context.Background()
+	bulkResource := resourceUsersBulk()
+	content := "email,name\na@example.com,User A\nb@example.com,User B\n"
+
+	// New users are shown in the plan
+	diff, err := bulkResource.Diff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{"content": content}), nil)
+	if err != nil {
+		t.Fatalf("Failed to plan bulk users: %v", err)
+	}
+	if diff.Attributes["row_hashes.a@example.com"] == nil || diff.Attributes["row_hashes.b@example.com"] == nil || !diff.Attributes["users.%"].NewComputed {
+		t.Fatalf("Expected a planned hash for each user, got %v", diff.Attributes)
+	}
+
+	users, columns, _ := parseBulkUsersCSV(content)
+	rowHashes := bulkUserRowHashes(users, columns)
+	state := &terraform.InstanceState{
+		ID: "bulk-id",
+		Attributes: map[string]string{
+			"id":                       "bulk-id",
+			"content":                  content,
+			"users.%":                  "2",
+			"users.a@example.com":      "id-a",
+			"users.b@example.com":      "id-b",
+			"row_hashes.%":             "2",
+			"row_hashes.a@example.com": rowHashes["a@example.com"].(string),
+			"row_hashes.b@example.com": rowHashes["b@example.com"].(string),
+			"managed_columns.#":        "2",
+			"managed_columns.0":        "email",
+			"managed_columns.1":        "name",
+			"content_hash":             bulkUsersContentHash(rowHashes),
+		},
+	}
+
+	// No changes are planned when the users match
+	diff, err = bulkResource.Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{"content": content}), nil)
+	if err != nil {
+		t.Fatalf("Failed to plan bulk users: %v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("Expected no changes, got %v", diff.Attributes)
+	}
+
+	// Only the changed user is shown in the plan
+	changedContent := "email,name\na@example.com,User A\nb@example.com,Renamed User B\n"
+	diff, err = bulkResource.Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{"content": changedContent}), nil)
+	if err != nil {
+		t.Fatalf("Failed to plan bulk users: %v", err)
+	}
+	if diff.Attributes["row_hashes.b@example.com"] == nil || diff.Attributes["row_hashes.a@example.com"] != nil || diff.Attributes["users.%"] != nil {
+		t.Errorf("Expected only user B to change, got %v", diff.Attributes)
+	}
+}
+
+// failingBulkUsersProxy fails to update users with a given name and counts the updates of each user
+type failingBulkUsersProxy struct {
+	usersProxy
+	failName string
+	patches  map[string]int
+}
+
+func (p *failingBulkUsersProxy) PatchUser(userId string, body platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	if body.Name != nil && *body.Name == p.failName {
+		return nil, &platformclientv2.APIResponse{StatusCode: 500}, fmt.Errorf("failed to update %s", p.failName)
+	}
+	p.patches[userId]++
+	return p.usersProxy.PatchUser(userId, body)
+}
+
+func TestUnitUsersBulkResume(t *testing.T) {
+	_, sharedMeta := setupFakeAPI(t)
+	ctx := context.Background()
+	bulkResource := resourceUsersBulk()
+
+	usersAPI := &failingBulkUsersProxy{
+		usersProxy: sharedMeta.usersProxy(),
+		failName:   "User B",
+		patches:    make(map[string]int),
+	}
+	meta := *sharedMeta
+	meta.proxies = &apiProxies{users: usersAPI}
+
+	d := schema.TestResourceDataRaw(t, bulkResource.Schema, map[string]interface{}{
+		"content": "email,name\na@example.com,User A\nb@example.com,User B\n",
+	})
+	if diagErr := createUsersBulk(ctx, d, &meta); !diagErr.HasError() {
+		t.Fatal("Expected updating user B to fail")
+	}
+
+	// Users applied before the failure are saved to state
+	userIDs := d.Get("users").(map[string]interface{})
+	rowHashes := d.Get("row_hashes").(map[string]interface{})
+	idA, _ := userIDs["a@example.com"].(string)
+	idB, _ := userIDs["b@example.com"].(string)
+	if idA == "" || idB == "" {
+		t.Fatalf("Expected created users to be saved to state, got %v", userIDs)
+	}
+	if rowHashes["a@example.com"] == "" || rowHashes["b@example.com"] != "" {
+		t.Errorf("Expected only user A to be applied, got %v", rowHashes)
+	}
+
+	// Retrying only updates the user that failed
+	usersAPI.failName = ""
+	d = bulkResource.Data(d.State())
+	if diagErr := updateUsersBulk(ctx, d, &meta); diagErr.HasError() {
+		t.Fatalf("Failed to update bulk users: %v", diagErr)
+	}
+	if usersAPI.patches[idA] != 1 || usersAPI.patches[idB] != 1 {
+		t.Errorf("Expected each user to be updated once, got %v", usersAPI.patches)
+	}
+	if rowHashes := d.Get("row_hashes").(map[string]interface{}); rowHashes["b@example.com"] == "" {
+		t.Errorf("Expected user B to be applied, got %v", rowHashes)
+	}
+}
+
+func TestUnitUsersBulkDeletionGuards(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	ctx := context.Background()
+	bulkResource := resourceUsersBulk()
+
+	d := schema.TestResourceDataRaw(t, bulkResource.Schema, map[string]interface{}{
+		"content": "email,name\na@example.com,User A\nb@example.com,User B\n",
+	})
+	if diagErr := createUsersBulk(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to create bulk users: %v", diagErr)
+	}
+	userIDs := d.Get("users").(map[string]interface{})
+
+	// Users removed from the file are not deleted when deletion protection is enabled
+	state := d.State()
+	state.Attributes["content"] = "email,name\na@example.com,User A\n"
+	state.Attributes["deletion_protection"] = "true"
+	d = bulkResource.Data(state)
+	if diagErr := updateUsersBulk(ctx, d, meta); !diagErr.HasError() || !strings.Contains(diagErr[0].Summary, "protected from deletion") {
+		t.Errorf("Expected removing a protected user to fail, got %v", diagErr)
+	}
+	if user := fakeAPI.get("/api/v2/users", userIDs["b@example.com"].(string)); user["state"] == "deleted" {
+		t.Error("Expected the protected user not to be deleted")
+	}
+	if len(d.Get("users").(map[string]interface{})) != 2 {
+		t.Errorf("Expected both users to remain in state, got %v", d.Get("users"))
+	}
+
+	// Empty files are only applied when explicitly allowed
+	emptyData := schema.TestResourceDataRaw(t, bulkResource.Schema, map[string]interface{}{
+		"content": "email,name\n",
+	})
+	if _, _, err := loadBulkUsers(emptyData); err == nil {
+		t.Error("Expected an empty users file to be refused")
+	}
+	emptyData.Set("allow_empty", true)
+	if _, _, err := loadBulkUsers(emptyData); err != nil {
+		t.Errorf("Expected an empty users file to be allowed: %v", err)
+	}
+}
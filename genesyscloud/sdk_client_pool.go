@@ -2,6 +2,7 @@ package genesyscloud
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"sync"
 
@@ -65,6 +66,11 @@ func (p *SDKClientPool) acquire() *platformclientv2.Configuration {
 	return <-p.pool
 }
 
+// size returns the maximum number of clients in the pool
+func (p *SDKClientPool) size() int {
+	return cap(p.pool)
+}
+
 func (p *SDKClientPool) release(c *platformclientv2.Configuration) {
 	select {
 	case p.pool <- c:
@@ -75,9 +81,10 @@ func (p *SDKClientPool) release(c *platformclientv2.Configuration) {
 
 type resContextFunc func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
 type getAllConfigFunc func(context.Context, *platformclientv2.Configuration) (ResourceIDMetaMap, diag.Diagnostics)
+type exportFilesConfigFunc func(context.Context, string, string, jsonMap, string, *platformclientv2.Configuration) ([]string, diag.Diagnostics)
 
 func createWithPooledClient(method resContextFunc) schema.CreateContextFunc {
-	return schema.CreateContextFunc(runWithPooledClient(method))
+	return schema.CreateContextFunc(blockIfReadOnly("create", runWithPooledClient(method)))
 }
 
 func readWithPooledClient(method resContextFunc) schema.ReadContextFunc {
@@ -85,11 +92,30 @@ func readWithPooledClient(method resContextFunc) schema.ReadContextFunc {
 }
 
 func updateWithPooledClient(method resContextFunc) schema.UpdateContextFunc {
-	return schema.UpdateContextFunc(runWithPooledClient(method))
+	return schema.UpdateContextFunc(blockIfReadOnly("update", runWithPooledClient(method)))
 }
 
 func deleteWithPooledClient(method resContextFunc) schema.DeleteContextFunc {
-	return schema.DeleteContextFunc(runWithPooledClient(method))
+	return schema.DeleteContextFunc(blockIfReadOnly("delete", runWithPooledClient(method)))
+}
+
+// Return an error before a resource method makes any API calls if the provider is read-only
+func blockIfReadOnly(operation string, method resContextFunc) resContextFunc {
+	return func(ctx context.Context, r *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := checkWriteAllowed(meta, operation); err != nil {
+			return diag.FromErr(err)
+		}
+		return method(ctx, r, meta)
+	}
+}
+
+// checkWriteAllowed returns an error if the provider is configured as read-only.
+// Functions that write to the org outside of resource create, update, and delete must call this first.
+func checkWriteAllowed(meta interface{}, operation string) error {
+	if m, ok := meta.(*providerMeta); ok && m.ReadOnly {
+		return fmt.Errorf("cannot %s: the provider is configured with read_only = true and will not change any objects", operation)
+	}
+	return nil
 }
 
 // Inject a pooled SDK client connection into a resource method's meta argument
@@ -129,3 +155,20 @@ func getAllWithPooledClient(method getAllConfigFunc) GetAllResourcesFunc {
 		return method(ctx, clientConfig)
 	}
 }
+
+// Inject a pooled SDK client connection into an exporter's file export method
+func exportFilesWithPooledClient(method exportFilesConfigFunc) ExportFilesFunc {
+	return func(ctx context.Context, id string, resourceName string, configMap jsonMap, exportDir string, _ interface{}) ([]string, diag.Diagnostics) {
+		clientConfig := sdkClientPool.acquire()
+		defer sdkClientPool.release(clientConfig)
+
+		// Check if the request has been cancelled
+		select {
+		case <-ctx.Done():
+			return nil, diag.FromErr(ctx.Err()) // Error somewhere, terminate
+		default:
+		}
+
+		return method(ctx, id, resourceName, configMap, exportDir, clientConfig)
+	}
+}
@@ -0,0 +1,253 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Resource types that are not swept as they do not create objects that can leak between test runs,
+// e.g. org-wide settings or role assignments that are deleted with their user or group.
+var sweeperExcludedTypes = map[string]bool{
+	"genesyscloud_architect_datatable_row": true,
+	"genesyscloud_group_roles":             true,
+	"genesyscloud_idp_adfs":                true,
+	"genesyscloud_idp_generic":             true,
+	"genesyscloud_idp_gsuite":              true,
+	"genesyscloud_idp_okta":                true,
+	"genesyscloud_idp_onelogin":            true,
+	"genesyscloud_idp_ping":                true,
+	"genesyscloud_idp_salesforce":          true,
+	"genesyscloud_routing_utilization":     true,
+	"genesyscloud_user_roles":              true,
+}
+
+// Dependencies between resource types that are not exporter references. Objects of the key type are swept
+// after objects of the listed types, as the API does not allow deleting objects that are still in use.
+var sweeperExtraDependencies = map[string][]string{
+	"genesyscloud_architect_schedulegroups": {"genesyscloud_architect_ivr"},
+	"genesyscloud_architect_schedules":      {"genesyscloud_architect_schedulegroups"},
+}
+
+// Run the sweepers with: go test ./genesyscloud -v -sweep=<region>
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	for resType, dependencies := range getSweeperDependencies(getResourceExporters(nil)) {
+		resource.AddTestSweepers(resType, &resource.Sweeper{
+			Name:         resType,
+			Dependencies: dependencies,
+			F:            sweepResourceType(resType),
+		})
+	}
+}
+
+// getSweeperDependencies returns the resource types that must be swept before each resource type.
+// Objects are deleted before the objects they reference, e.g. phones before sites. References that
+// would create a dependency cycle are ignored as the sweeper framework cannot run cyclic dependencies.
+func getSweeperDependencies(exporters map[string]*ResourceExporter) map[string][]string {
+	resTypes := make([]string, 0, len(exporters))
+	for resType := range exporters {
+		if !sweeperExcludedTypes[resType] {
+			resTypes = append(resTypes, resType)
+		}
+	}
+	sort.Strings(resTypes)
+
+	dependencies := make(map[string][]string, len(resTypes))
+	for _, resType := range resTypes {
+		dependencies[resType] = []string{}
+	}
+	for resType, extraDeps := range sweeperExtraDependencies {
+		if _, swept := dependencies[resType]; !swept {
+			continue
+		}
+		for _, dep := range extraDeps {
+			if _, swept := dependencies[dep]; swept {
+				dependencies[resType] = append(dependencies[resType], dep)
+			}
+		}
+	}
+
+	var dependsOn func(resType, target string, visited map[string]bool) bool
+	dependsOn = func(resType, target string, visited map[string]bool) bool {
+		if resType == target {
+			return true
+		}
+		visited[resType] = true
+		for _, dep := range dependencies[resType] {
+			if !visited[dep] && dependsOn(dep, target, visited) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, resType := range resTypes {
+		attrs := make([]string, 0, len(exporters[resType].RefAttrs))
+		for attr := range exporters[resType].RefAttrs {
+			attrs = append(attrs, attr)
+		}
+		sort.Strings(attrs)
+
+		for _, attr := range attrs {
+			refType := exporters[resType].RefAttrs[attr].RefType
+			if _, swept := dependencies[refType]; !swept || refType == resType || stringInSlice(resType, dependencies[refType]) {
+				continue
+			}
+			if dependsOn(resType, refType, map[string]bool{}) {
+				log.Printf("Ignoring sweeper dependency of %s on %s to avoid a cycle", refType, resType)
+				continue
+			}
+			dependencies[refType] = append(dependencies[refType], resType)
+		}
+	}
+	return dependencies
+}
+
+var (
+	sweeperProvider *schema.Provider
+	sweeperRegion   string
+)
+
+// The SDK client pool can only be initialized once, so all sweepers must run in the same region
+func getSweeperProvider(region string) (*schema.Provider, error) {
+	if sweeperProvider != nil {
+		if region != sweeperRegion {
+			return nil, fmt.Errorf("sweepers can only run in a single region. Already configured for %s", sweeperRegion)
+		}
+		return sweeperProvider, nil
+	}
+
+	provider := New("0.1.0")()
+	diagErr := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"oauthclient_id":     os.Getenv("GENESYSCLOUD_OAUTHCLIENT_ID"),
+		"oauthclient_secret": os.Getenv("GENESYSCLOUD_OAUTHCLIENT_SECRET"),
+		"aws_region":         region,
+	}))
+	if diagErr.HasError() {
+		return nil, fmt.Errorf("failed to configure provider for region %s: %v", region, diagErr)
+	}
+	sweeperProvider = provider
+	sweeperRegion = region
+	return provider, nil
+}
+
+// Acceptance tests generate object names with a test prefix ending in a UUID, e.g. "Terraform Skill-<uuid>",
+// "test edge group <uuid>", or "terraform-<uuid>@example.com". Names are only swept if they match this convention
+// so objects that happen to contain a UUID are never deleted from a shared org.
+var testResourceNamePattern = regexp.MustCompile(`(?i)^(terraform|tf|test|cx as code)[^@]*` + uuidPattern.String() + `(@example\.com)?$`)
+
+func isTestResourceName(name string) bool {
+	return testResourceNamePattern.MatchString(name)
+}
+
+func sweepResourceType(resType string) resource.SweeperFunc {
+	return func(region string) error {
+		provider, err := getSweeperProvider(region)
+		if err != nil {
+			return err
+		}
+		ctx := context.Background()
+		meta := provider.Meta()
+		res := provider.ResourcesMap[resType]
+
+		resources, diagErr := getResourceExporters([]string{resType})[resType].GetResourcesFunc(ctx)
+		if diagErr.HasError() {
+			return fmt.Errorf("failed to get %s resources: %v", resType, diagErr)
+		}
+
+		var sweepErrs []string
+		for id, resMeta := range resources {
+			if !isTestResourceName(resMeta.Name) {
+				continue
+			}
+
+			log.Printf("Sweeping %s %s (%s)", resType, resMeta.Name, id)
+			d := res.TestResourceData()
+			d.SetId(id)
+			// Read the object first as delete functions may use attributes from state
+			if diagErr := res.ReadContext(ctx, d, meta); diagErr.HasError() {
+				sweepErrs = append(sweepErrs, fmt.Sprintf("failed to read %s %s: %v", resType, id, diagErr))
+				continue
+			}
+			if d.Id() == "" {
+				continue
+			}
+			if diagErr := res.DeleteContext(ctx, d, meta); diagErr.HasError() {
+				sweepErrs = append(sweepErrs, fmt.Sprintf("failed to delete %s %s: %v", resType, id, diagErr))
+			}
+		}
+
+		if len(sweepErrs) > 0 {
+			return fmt.Errorf("failed to sweep %s: %s", resType, strings.Join(sweepErrs, "; "))
+		}
+		return nil
+	}
+}
+
+func TestSweeperDependencies(t *testing.T) {
+	dependencies := getSweeperDependencies(getResourceExporters(nil))
+
+	for _, expected := range [][2]string{
+		{"genesyscloud_architect_schedulegroups", "genesyscloud_architect_ivr"},
+		{"genesyscloud_architect_schedules", "genesyscloud_architect_schedulegroups"},
+		{"genesyscloud_telephony_providers_edges_site", "genesyscloud_telephony_providers_edges_phone"},
+	} {
+		if !stringInSlice(expected[1], dependencies[expected[0]]) {
+			t.Errorf("Expected %s to be swept before %s. Dependencies: %v", expected[1], expected[0], dependencies[expected[0]])
+		}
+	}
+
+	for resType := range sweeperExcludedTypes {
+		if _, ok := dependencies[resType]; ok {
+			t.Errorf("Excluded type %s should not have a sweeper", resType)
+		}
+	}
+
+	// The sweeper framework recurses through dependencies, so they must not contain cycles
+	var visit func(resType string, path map[string]bool)
+	visit = func(resType string, path map[string]bool) {
+		if path[resType] {
+			t.Fatalf("Sweeper dependency cycle found at %s", resType)
+		}
+		path[resType] = true
+		for _, dep := range dependencies[resType] {
+			visit(dep, path)
+		}
+		delete(path, resType)
+	}
+	for resType := range dependencies {
+		visit(resType, map[string]bool{})
+	}
+}
+
+func TestSweeperResourceNames(t *testing.T) {
+	for name, expected := range map[string]bool{
+		"Terraform Skill-bc4cd5d4-87a5-4ab1-8b1c-0c0e0b6a7bd1":       true,
+		"terraform-bc4cd5d4-87a5-4ab1-8b1c-0c0e0b6a7bd1@example.com": true,
+		"test edge group bc4cd5d4-87a5-4ab1-8b1c-0c0e0b6a7bd1":       true,
+		"CX as Code Schedulebc4cd5d4-87a5-4ab1-8b1c-0c0e0b6a7bd1":    true,
+		"Sales Queue bc4cd5d4-87a5-4ab1-8b1c-0c0e0b6a7bd1":           false,
+		"Terraform Skill": false,
+		"terraform-bc4cd5d4-87a5-4ab1-8b1c-0c0e0b6a7bd1 (copy)":      false,
+		"agent-bc4cd5d4-87a5-4ab1-8b1c-0c0e0b6a7bd1@example.com":     false,
+		"terraform-bc4cd5d4-87a5-4ab1-8b1c-0c0e0b6a7bd1@genesys.com": false,
+		"Imported bc4cd5d4-87a5-4ab1-8b1c-0c0e0b6a7bd1 terraform":    false,
+	} {
+		if isTestResourceName(name) != expected {
+			t.Errorf("Expected isTestResourceName(%q) to be %v", name, expected)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -153,6 +154,129 @@ func updateSubjectRoles(ctx context.Context, d *schema.ResourceData, authAPI *pl
 	return nil
 }
 
+// Single role grants are identified by the subject and role IDs
+func createSubjectRoleID(subjectID string, roleID string) string {
+	return strings.Join([]string{subjectID, roleID}, "/")
+}
+
+func splitSubjectRoleID(id string) (string, string) {
+	split := strings.SplitN(id, "/", 2)
+	if len(split) == 2 {
+		return split[0], split[1]
+	}
+	return "", ""
+}
+
+// Get the divisions a role is granted in directly to a subject
+func getSubjectRoleDivisions(subjectID string, roleID string, authAPI *platformclientv2.AuthorizationApi) ([]string, diag.Diagnostics) {
+	grants, err := getAssignedGrants(subjectID, authAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	var divisionIDs []string
+	for _, grant := range grants {
+		if grant.Role != nil && grant.Role.Id != nil && *grant.Role.Id == roleID && grant.Division != nil && grant.Division.Id != nil {
+			divisionIDs = append(divisionIDs, *grant.Division.Id)
+		}
+	}
+	return divisionIDs, nil
+}
+
+// Unlike the roles resources, a single role resource only reads the divisions it manages.
+// Grants made outside of this resource are ignored unless the resource is being imported.
+func readSubjectRole(ctx context.Context, d *schema.ResourceData, subjectID string, roleID string, authAPI *platformclientv2.AuthorizationApi) diag.Diagnostics {
+	managedDivs := *setToStringList(d.Get("division_ids").(*schema.Set))
+
+	return withRetriesForRead(ctx, 30*time.Second, d, func() *resource.RetryError {
+		divisionIDs, err := getSubjectRoleDivisions(subjectID, roleID, authAPI)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("%v", err))
+		}
+
+		if len(managedDivs) > 0 {
+			divisionIDs = sliceDifference(divisionIDs, sliceDifference(divisionIDs, managedDivs))
+		}
+
+		if d.IsNewResource() && len(sliceDifference(managedDivs, divisionIDs)) > 0 {
+			// New grants may not be returned immediately
+			return resource.RetryableError(fmt.Errorf("Role %s not yet granted to subject %s in all divisions", roleID, subjectID))
+		}
+
+		if len(divisionIDs) == 0 {
+			// Role is no longer granted in any managed division
+			d.SetId("")
+			return nil
+		}
+		d.Set("division_ids", stringListToSet(divisionIDs))
+		return nil
+	})
+}
+
+// Adds and removes grants for the configured divisions of a single role. Other grants for the subject are not modified.
+func updateSubjectRole(d *schema.ResourceData, subjectID string, roleID string, authAPI *platformclientv2.AuthorizationApi, subjectType string) diag.Diagnostics {
+	configDivs := *setToStringList(d.Get("division_ids").(*schema.Set))
+	if len(configDivs) == 0 {
+		// No division set. Use the home division
+		homeDiv, diagErr := getHomeDivisionID()
+		if diagErr != nil {
+			return diagErr
+		}
+		configDivs = []string{homeDiv}
+	}
+
+	var managedDivs []string
+	if !d.IsNewResource() {
+		oldDivs, _ := d.GetChange("division_ids")
+		managedDivs = *setToStringList(oldDivs.(*schema.Set))
+	}
+
+	existingDivs, diagErr := getSubjectRoleDivisions(subjectID, roleID, authAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	for _, divID := range sliceDifference(managedDivs, configDivs) {
+		resp, err := authAPI.DeleteAuthorizationSubjectDivisionRole(subjectID, divID, roleID)
+		if err != nil && !isStatus404(resp) {
+			return diag.Errorf("Failed to remove role %s in division %s for subject %s: %s", roleID, divID, subjectID, err)
+		}
+	}
+
+	var grantsToAdd []string
+	for _, divID := range sliceDifference(configDivs, existingDivs) {
+		grantsToAdd = append(grantsToAdd, createRoleDivisionPair(roleID, divID))
+	}
+	if len(grantsToAdd) > 0 {
+		// In some cases new roles or divisions have not yet been added to the auth service cache causing 404s that should be retried.
+		diagErr = retryWhen(isStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			resp, err := authAPI.PostAuthorizationSubjectBulkadd(subjectID, roleDivPairsToGrants(grantsToAdd), subjectType)
+			if err != nil {
+				return resp, diag.Errorf("Failed to add role %s for subject %s: %s", roleID, subjectID, err)
+			}
+			return nil, nil
+		})
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	// Track the divisions granted by this resource so the read ignores other grants of the role
+	d.Set("division_ids", stringListToSet(configDivs))
+	return nil
+}
+
+// Removes the grants of a single role in the divisions managed by this resource
+func deleteSubjectRole(d *schema.ResourceData, subjectID string, roleID string, authAPI *platformclientv2.AuthorizationApi) diag.Diagnostics {
+	for _, divID := range *setToStringList(d.Get("division_ids").(*schema.Set)) {
+		resp, err := authAPI.DeleteAuthorizationSubjectDivisionRole(subjectID, divID, roleID)
+		if err != nil && !isStatus404(resp) {
+			return diag.Errorf("Failed to remove role %s in division %s for subject %s: %s", roleID, divID, subjectID, err)
+		}
+	}
+	return nil
+}
+
 func createRoleDivisionPair(roleID string, divisionID string) string {
 	return roleID + ":" + divisionID
 }
@@ -0,0 +1,35 @@
+package genesyscloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+// getAdoptableObjectID returns the ID of an existing object with the same name after a create request failed,
+// if the provider's adopt_existing setting is enabled. Only name conflicts are adopted, so other errors such as
+// validation failures are returned without looking for an existing object.
+// The existing object is found with the search in the resource's data source.
+func getAdoptableObjectID(ctx context.Context, meta interface{}, resp *platformclientv2.APIResponse, dataSource func() *schema.Resource, name string) (string, bool) {
+	m, ok := meta.(*providerMeta)
+	if !ok || !m.AdoptExisting || !isNameConflict(resp) {
+		return "", false
+	}
+
+	id, err := lookupIDByName(ctx, dataSource, "name", name, meta)
+	if err != nil {
+		log.Printf("No existing object to adopt with name %s: %v", name, err)
+		return "", false
+	}
+	return id, true
+}
+
+// The Public API rejects duplicate names with a 409 or a general.conflict error code
+func isNameConflict(resp *platformclientv2.APIResponse) bool {
+	if isStatus409(resp) {
+		return true
+	}
+	return resp != nil && resp.Error != nil && resp.Error.Code == "general.conflict"
+}
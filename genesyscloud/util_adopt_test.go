@@ -0,0 +1,35 @@
+package genesyscloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+func TestUnitAdoptOnlyNameConflicts(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	adoptMeta := *meta
+	adoptMeta.AdoptExisting = true
+	existing := fakeAPI.create("/api/v2/routing/skills", map[string]interface{}{"name": "Existing Skill"})
+
+	testCases := []struct {
+		description string
+		resp        *platformclientv2.APIResponse
+		expected    bool
+	}{
+		{"conflict status", &platformclientv2.APIResponse{StatusCode: 409}, true},
+		{"conflict code", &platformclientv2.APIResponse{StatusCode: 400, Error: &platformclientv2.APIError{Code: "general.conflict"}}, true},
+		{"validation error", &platformclientv2.APIResponse{StatusCode: 400, Error: &platformclientv2.APIError{Code: "bad.request"}}, false},
+		{"no response", nil, false},
+	}
+	for _, tc := range testCases {
+		id, adopted := getAdoptableObjectID(context.Background(), &adoptMeta, tc.resp, dataSourceRoutingSkill, "Existing Skill")
+		if adopted != tc.expected {
+			t.Errorf("%s: expected adopted to be %v", tc.description, tc.expected)
+		}
+		if adopted && id != existing["id"] {
+			t.Errorf("%s: expected existing skill %v to be adopted, got %s", tc.description, existing["id"], id)
+		}
+	}
+}
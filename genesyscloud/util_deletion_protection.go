@@ -0,0 +1,42 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Prevents this object from being deleted by Terraform. If not set, the provider's `deletion_protection` setting is used. Set to false and apply before deleting the object.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	}
+}
+
+// protectFromDeletion wraps a delete function for a resource with a deletion_protection attribute.
+// The delete function is not called if the resource or provider enables deletion protection.
+func protectFromDeletion(objType string, method resContextFunc) resContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if isDeletionProtected(d, meta) {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("%s %s is protected from deletion", objType, d.Id()),
+				Detail: "To delete this object, set deletion_protection = false on the resource and apply the change before deleting it. " +
+					"If deletion_protection is not set on the resource, it is enabled by the provider's deletion_protection setting.",
+			}}
+		}
+		return method(ctx, d, meta)
+	}
+}
+
+func isDeletionProtected(d *schema.ResourceData, meta interface{}) bool {
+	// GetOkExists distinguishes an explicit false from an unset attribute
+	if protected, ok := d.GetOkExists("deletion_protection"); ok {
+		return protected.(bool)
+	}
+	m, ok := meta.(*providerMeta)
+	return ok && m.DeletionProtection
+}
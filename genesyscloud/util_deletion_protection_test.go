@@ -0,0 +1,44 @@
+package genesyscloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitDeletionProtection(t *testing.T) {
+	queueSchema := resourceRoutingQueue().Schema
+
+	testCases := []struct {
+		description      string
+		config           map[string]interface{}
+		providerDefault  bool
+		expectedDeletion bool
+	}{
+		{"unset with provider default off", map[string]interface{}{"name": "Queue"}, false, true},
+		{"unset with provider default on", map[string]interface{}{"name": "Queue"}, true, false},
+		{"enabled on resource", map[string]interface{}{"name": "Queue", "deletion_protection": true}, false, false},
+		{"disabled on resource overrides provider default", map[string]interface{}{"name": "Queue", "deletion_protection": false}, true, true},
+	}
+
+	for _, tc := range testCases {
+		deleted := false
+		deleteFunc := protectFromDeletion("Queue", func(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			deleted = true
+			return nil
+		})
+
+		d := schema.TestResourceDataRaw(t, queueSchema, tc.config)
+		d.SetId("queue-id")
+		diagErr := deleteFunc(context.Background(), d, &providerMeta{DeletionProtection: tc.providerDefault})
+
+		if deleted != tc.expectedDeletion {
+			t.Errorf("%s: expected deletion %t, got %t", tc.description, tc.expectedDeletion, deleted)
+		}
+		if diagErr.HasError() == tc.expectedDeletion {
+			t.Errorf("%s: unexpected diagnostics %v", tc.description, diagErr)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Import IDs starting with this prefix are looked up by name instead of ID, e.g. "name:Sales Queue"
+const importNamePrefix = "name:"
+
+type importLookupContextKey struct{}
+
+// importByName creates an importer that accepts an object ID or "name:<value>". Names are resolved to an ID
+// with the search in the resource's data source, using lookupAttr as the data source's search attribute.
+func importByName(dataSource func() *schema.Resource, lookupAttr string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			name, byName := getImportName(d.Id())
+			if !byName {
+				return []*schema.ResourceData{d}, nil
+			}
+			id, err := lookupIDByName(ctx, dataSource, lookupAttr, name, meta)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(id)
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// getImportName returns the name in an import ID using the name prefix
+func getImportName(importID string) (string, bool) {
+	if !strings.HasPrefix(importID, importNamePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(importID, importNamePrefix), true
+}
+
+// lookupIDByName runs a data source read to find the ID of the object with the given name
+func lookupIDByName(ctx context.Context, dataSource func() *schema.Resource, lookupAttr string, name string, meta interface{}) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("import ID %s must include a %s", importNamePrefix, lookupAttr)
+	}
+
+	lookup := dataSource()
+	d := lookup.Data(nil)
+	if err := d.Set(lookupAttr, name); err != nil {
+		return "", err
+	}
+
+	diagErr := lookup.ReadContext(context.WithValue(ctx, importLookupContextKey{}, true), d, meta)
+	if diagErr.HasError() {
+		return "", fmt.Errorf("failed to find object to import with %s %s: %v", lookupAttr, name, diagErr)
+	}
+	if d.Id() == "" {
+		return "", fmt.Errorf("no object found to import with %s %s", lookupAttr, name)
+	}
+	return d.Id(), nil
+}
+
+// checkImportMatches returns an error if a data source found more than one object while resolving an import by name.
+// Data sources select the first match otherwise.
+func checkImportMatches(ctx context.Context, matches int, name string) error {
+	if matches > 1 && isImportLookup(ctx) {
+		return ambiguousImportError(name, matches)
+	}
+	return nil
+}
+
+func ambiguousImportError(name string, matches int) error {
+	return fmt.Errorf("%s is ambiguous: found %d objects with that name. Import by ID instead", name, matches)
+}
+
+func isImportLookup(ctx context.Context) bool {
+	lookup, _ := ctx.Value(importLookupContextKey{}).(bool)
+	return lookup
+}
@@ -0,0 +1,93 @@
+package genesyscloud
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func importResourceForTest(t *testing.T, res *schema.Resource, importID string, meta interface{}) (*schema.ResourceData, error) {
+	d := res.Data(nil)
+	d.SetId(importID)
+	imported, err := res.Importer.StateContext(context.Background(), d, meta)
+	if err != nil {
+		return nil, err
+	}
+	if len(imported) != 1 {
+		t.Fatalf("Expected 1 imported resource, got %d", len(imported))
+	}
+	return imported[0], nil
+}
+
+func TestUnitImportByName(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+
+	skill := fakeAPI.create("/api/v2/routing/skills", map[string]interface{}{"name": "Import Skill"})
+	user := fakeAPI.create("/api/v2/users", map[string]interface{}{"name": "Import User", "email": "import@example.com"})
+	fakeAPI.create("/api/v2/groups", map[string]interface{}{"name": "Duplicate Group"})
+	fakeAPI.create("/api/v2/groups", map[string]interface{}{"name": "Duplicate Group"})
+	datatable := fakeAPI.create("/api/v2/flows/datatables", map[string]interface{}{"name": "Import Table"})
+
+	d, err := importResourceForTest(t, resourceRoutingSkill(), "name:Import Skill", meta)
+	if err != nil {
+		t.Fatalf("Failed to import skill by name: %v", err)
+	}
+	if d.Id() != skill["id"] {
+		t.Errorf("Expected skill ID %v, got %s", skill["id"], d.Id())
+	}
+
+	d, err = importResourceForTest(t, resourceRoutingSkill(), "skill-id", meta)
+	if err != nil || d.Id() != "skill-id" {
+		t.Errorf("Expected import by ID to pass through the ID, got %s: %v", d.Id(), err)
+	}
+
+	d, err = importResourceForTest(t, resourceUser(), "name:import@example.com", meta)
+	if err != nil {
+		t.Fatalf("Failed to import user by email: %v", err)
+	}
+	if d.Id() != user["id"] {
+		t.Errorf("Expected user ID %v, got %s", user["id"], d.Id())
+	}
+
+	d, err = importResourceForTest(t, resourceArchitectDatatableRow(), "name:Import Table/row-key", meta)
+	if err != nil {
+		t.Fatalf("Failed to import datatable row by table name: %v", err)
+	}
+	if expected := createDatatableRowId(datatable["id"].(string), "row-key"); d.Id() != expected {
+		t.Errorf("Expected datatable row ID %s, got %s", expected, d.Id())
+	}
+
+	if _, err = importResourceForTest(t, resourceGroup(), "name:Duplicate Group", meta); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("Expected ambiguous group name to fail import, got: %v", err)
+	}
+
+	if _, err = importResourceForTest(t, resourceArchitectDatatableRow(), "name:Import Table", meta); err == nil {
+		t.Error("Expected datatable row import without a key to fail")
+	}
+
+	// Only exact name matches make an import ambiguous
+	queue := fakeAPI.create("/api/v2/routing/queues", map[string]interface{}{"name": "Sales Queue"})
+	fakeAPI.create("/api/v2/routing/queues", map[string]interface{}{"name": "sales queue"})
+	d, err = importResourceForTest(t, resourceRoutingQueue(), "name:Sales Queue", meta)
+	if err != nil {
+		t.Fatalf("Failed to import queue by name: %v", err)
+	}
+	if d.Id() != queue["id"] {
+		t.Errorf("Expected queue ID %v, got %s", queue["id"], d.Id())
+	}
+
+	fakeAPI.create("/api/v2/routing/queues", map[string]interface{}{"name": "Duplicate Queue"})
+	fakeAPI.create("/api/v2/routing/queues", map[string]interface{}{"name": "Duplicate Queue"})
+	if _, err = importResourceForTest(t, resourceRoutingQueue(), "name:Duplicate Queue", meta); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("Expected ambiguous queue name to fail import, got: %v", err)
+	}
+
+	// Data source reads outside of an import still use the first match
+	dataSource := dataSourceRoutingQueue()
+	queueData := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"name": "Duplicate Queue"})
+	if diagErr := dataSource.ReadContext(context.Background(), queueData, meta); diagErr.HasError() {
+		t.Errorf("Expected queue data source to use the first match, got: %v", diagErr)
+	}
+}
@@ -0,0 +1,164 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v56/platformclientv2"
+)
+
+// Value of attributes that will not be known until apply, e.g. the ID of a resource that has not been created yet
+const unknownAttributeValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// referenceValidator returns an error if an ID does not refer to an existing object of the expected type
+type referenceValidator func(sdkConfig *platformclientv2.Configuration, id string) error
+
+// validateReferencesDiff creates a CustomizeDiff function that checks new and changed references when the
+// validate_references provider setting is enabled. Validators are keyed by attribute path. Attributes in nested
+// blocks are separated by dots, e.g. "routing_skills.skill_id".
+func validateReferencesDiff(validators map[string]referenceValidator) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		m, ok := meta.(*providerMeta)
+		if !ok || !m.ValidateReferences {
+			return nil
+		}
+
+		var errs []string
+		for attrPath, validator := range validators {
+			path := strings.Split(attrPath, ".")
+			if !diff.HasChange(path[0]) {
+				continue
+			}
+			oldVal, newVal := diff.GetChange(path[0])
+			oldIDs := getReferenceIDs(oldVal, path[1:])
+			for _, id := range getReferenceIDs(newVal, path[1:]) {
+				if id == "" || id == unknownAttributeValue || stringInSlice(id, oldIDs) {
+					continue
+				}
+				if err := validator(m.ClientConfig, id); err != nil {
+					errs = append(errs, fmt.Sprintf("%s: %v", attrPath, err))
+				}
+			}
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("invalid references: %s", strings.Join(errs, "; "))
+		}
+		return nil
+	}
+}
+
+// getReferenceIDs returns the string values found at a path of nested block attributes
+func getReferenceIDs(value interface{}, path []string) []string {
+	switch v := value.(type) {
+	case string:
+		if len(path) == 0 {
+			return []string{v}
+		}
+	case *schema.Set:
+		return getReferenceIDs(v.List(), path)
+	case []interface{}:
+		var ids []string
+		for _, item := range v {
+			ids = append(ids, getReferenceIDs(item, path)...)
+		}
+		return ids
+	case map[string]interface{}:
+		if len(path) > 0 {
+			return getReferenceIDs(v[path[0]], path[1:])
+		}
+	}
+	return nil
+}
+
+func checkReferenceResponse(objType string, id string, resp *platformclientv2.APIResponse, err error) error {
+	if isStatus404(resp) {
+		return fmt.Errorf("%s %s does not exist", objType, id)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get %s %s: %v", objType, id, err)
+	}
+	return nil
+}
+
+// flowReference validates a flow ID. If flow types are specified, the flow must be one of those types, e.g. "inqueuecall".
+func flowReference(flowTypes ...string) referenceValidator {
+	return func(sdkConfig *platformclientv2.Configuration, id string) error {
+		flow, resp, err := platformclientv2.NewArchitectApiWithConfig(sdkConfig).GetFlow(id, false)
+		if err := checkReferenceResponse("flow", id, resp, err); err != nil {
+			return err
+		}
+		if len(flowTypes) == 0 {
+			return nil
+		}
+		for _, flowType := range flowTypes {
+			if flow.VarType != nil && strings.EqualFold(*flow.VarType, flowType) {
+				return nil
+			}
+		}
+		actualType := ""
+		if flow.VarType != nil {
+			actualType = *flow.VarType
+		}
+		return fmt.Errorf("flow %s has type %s. Expected type %s", id, actualType, strings.Join(flowTypes, " or "))
+	}
+}
+
+func scheduleGroupReference(sdkConfig *platformclientv2.Configuration, id string) error {
+	_, resp, err := platformclientv2.NewArchitectApiWithConfig(sdkConfig).GetArchitectSchedulegroup(id)
+	return checkReferenceResponse("schedule group", id, resp, err)
+}
+
+func promptReference(sdkConfig *platformclientv2.Configuration, id string) error {
+	_, resp, err := platformclientv2.NewArchitectApiWithConfig(sdkConfig).GetArchitectPrompt(id)
+	return checkReferenceResponse("prompt", id, resp, err)
+}
+
+func divisionReference(sdkConfig *platformclientv2.Configuration, id string) error {
+	_, resp, err := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig).GetAuthorizationDivision(id, false)
+	return checkReferenceResponse("division", id, resp, err)
+}
+
+func skillReference(sdkConfig *platformclientv2.Configuration, id string) error {
+	skill, resp, err := platformclientv2.NewRoutingApiWithConfig(sdkConfig).GetRoutingSkill(id)
+	if err := checkReferenceResponse("skill", id, resp, err); err != nil {
+		return err
+	}
+	if skill.State != nil && *skill.State == "deleted" {
+		return fmt.Errorf("skill %s has been deleted", id)
+	}
+	return nil
+}
+
+func languageReference(sdkConfig *platformclientv2.Configuration, id string) error {
+	language, resp, err := platformclientv2.NewLanguagesApiWithConfig(sdkConfig).GetRoutingLanguage(id)
+	if err := checkReferenceResponse("language", id, resp, err); err != nil {
+		return err
+	}
+	if language.State != nil && *language.State == "deleted" {
+		return fmt.Errorf("language %s has been deleted", id)
+	}
+	return nil
+}
+
+func wrapupcodeReference(sdkConfig *platformclientv2.Configuration, id string) error {
+	_, resp, err := platformclientv2.NewRoutingApiWithConfig(sdkConfig).GetRoutingWrapupcode(id)
+	return checkReferenceResponse("wrap-up code", id, resp, err)
+}
+
+func locationReference(sdkConfig *platformclientv2.Configuration, id string) error {
+	_, resp, err := platformclientv2.NewLocationsApiWithConfig(sdkConfig).GetLocation(id, nil)
+	return checkReferenceResponse("location", id, resp, err)
+}
+
+func userReference(sdkConfig *platformclientv2.Configuration, id string) error {
+	user, resp, err := platformclientv2.NewUsersApiWithConfig(sdkConfig).GetUser(id, nil, "", "")
+	if err := checkReferenceResponse("user", id, resp, err); err != nil {
+		return err
+	}
+	if user.State != nil && *user.State == "deleted" {
+		return fmt.Errorf("user %s has been deleted", id)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package genesyscloud
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitValidateReferences(t *testing.T) {
+	fakeAPI, meta := setupFakeAPI(t)
+	validatingMeta := *meta
+	validatingMeta.ValidateReferences = true
+
+	inQueueFlow := fakeAPI.create("/api/v2/flows", map[string]interface{}{"name": "In-Queue Flow", "type": "INQUEUECALL"})
+	inboundFlow := fakeAPI.create("/api/v2/flows", map[string]interface{}{"name": "Inbound Flow", "type": "INBOUNDCALL"})
+	skill := fakeAPI.create("/api/v2/routing/skills", map[string]interface{}{"name": "Reference Skill"})
+
+	planQueue := func(flowID string, meta interface{}) error {
+		_, err := resourceRoutingQueue().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":          "Reference Queue",
+			"queue_flow_id": flowID,
+		}), meta)
+		return err
+	}
+
+	if err := planQueue(inQueueFlow["id"].(string), &validatingMeta); err != nil {
+		t.Errorf("Expected in-queue flow reference to be valid: %v", err)
+	}
+	if err := planQueue(inboundFlow["id"].(string), &validatingMeta); err == nil || !strings.Contains(err.Error(), "Expected type inqueuecall") {
+		t.Errorf("Expected inbound call flow to be rejected as a queue flow, got: %v", err)
+	}
+	if err := planQueue("missing-flow-id", &validatingMeta); err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("Expected missing flow to be rejected, got: %v", err)
+	}
+	if err := planQueue("missing-flow-id", meta); err != nil {
+		t.Errorf("Expected references not to be checked when validate_references is off: %v", err)
+	}
+
+	planUserSkill := func(skillID string) error {
+		_, err := resourceUser().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"email": "reference@example.com",
+			"name":  "Reference User",
+			"routing_skills": []interface{}{
+				map[string]interface{}{"skill_id": skillID, "proficiency": 1},
+			},
+		}), &validatingMeta)
+		return err
+	}
+
+	if err := planUserSkill(skill["id"].(string)); err != nil {
+		t.Errorf("Expected skill reference to be valid: %v", err)
+	}
+	if err := planUserSkill("missing-skill-id"); err == nil || !strings.Contains(err.Error(), "routing_skills.skill_id") {
+		t.Errorf("Expected missing skill to be rejected, got: %v", err)
+	}
+}
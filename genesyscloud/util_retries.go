@@ -74,3 +74,17 @@ func isStatus400(resp *platformclientv2.APIResponse) bool {
 	}
 	return false
 }
+
+func isStatus403(resp *platformclientv2.APIResponse) bool {
+	if resp != nil && resp.StatusCode == 403 {
+		return true
+	}
+	return false
+}
+
+func isStatus409(resp *platformclientv2.APIResponse) bool {
+	if resp != nil && resp.StatusCode == 409 {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nyaruka/phonenumbers"
+)
+
+// stateMapUpgradeFunc updates a single object in a resource's raw state
+type stateMapUpgradeFunc func(stateMap map[string]interface{}) error
+
+// newStateUpgrader creates an upgrader from the given schema version to the next version.
+// priorResource must have the schema of the prior version. If the shape of the schema changes,
+// a copy of the prior schema must be kept for the upgrader, e.g. in a resourceUserV1() function.
+func newStateUpgrader(version int, priorResource *schema.Resource, upgrade stateMapUpgradeFunc) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    priorResource.CoreConfigSchema().ImpliedType(),
+		Upgrade: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+			if rawState == nil {
+				return rawState, nil
+			}
+			if err := upgrade(rawState); err != nil {
+				return nil, fmt.Errorf("failed to upgrade state from version %d: %v", version, err)
+			}
+			return rawState, nil
+		},
+	}
+}
+
+// upgradeNestedStateMaps calls upgrade for every object found at a path of nested block attributes in a raw state map,
+// e.g. []string{"addresses", "phone_numbers"}. Lists and sets of blocks are stored as lists in raw state.
+func upgradeNestedStateMaps(stateMap map[string]interface{}, path []string, upgrade stateMapUpgradeFunc) error {
+	if len(path) == 0 {
+		return upgrade(stateMap)
+	}
+
+	nested, ok := stateMap[path[0]].([]interface{})
+	if !ok {
+		// Attribute is not set
+		return nil
+	}
+	for _, item := range nested {
+		if nestedMap, ok := item.(map[string]interface{}); ok {
+			if err := upgradeNestedStateMaps(nestedMap, path[1:], upgrade); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// formatE164PhoneNumber formats a phone number in E.164 format, defaulting to the US country code.
+// Numbers that cannot be parsed are returned unchanged.
+func formatE164PhoneNumber(number string) string {
+	parsed, err := phonenumbers.Parse(number, "US")
+	if err != nil {
+		return number
+	}
+	return phonenumbers.Format(parsed, phonenumbers.E164)
+}